@@ -1,5 +1,8 @@
 module github.com/smyrman/blog/2020-06-test-with-expect/mypkg
 
-go 1.14
+go 1.23
 
-require github.com/searis/subtest v0.1.0
+require (
+	github.com/searis/subtest v0.1.0
+	golang.org/x/exp v0.0.0-20240506185415-9bf2ced13842
+)
@@ -2,6 +2,8 @@ package mypkg
 
 import (
 	"errors"
+
+	"golang.org/x/exp/constraints"
 )
 
 // Sum accepts any kind of slice or array holding only
@@ -9,3 +11,22 @@ import (
 func Sum(v interface{}) (float64, error) {
 	return -1, errors.New("NYI")
 }
+
+// SumOf returns the sum of s. For integer types, ErrOverflow is returned if the
+// running sum overflows at any point.
+//
+// Unlike Sum, which the article leaves as an exercise for the reader, SumOf
+// uses type parameters and is fully implemented.
+func SumOf[T constraints.Integer | constraints.Float](s []T) (T, error) {
+	var sum T
+	for _, v := range s {
+		next := sum + v
+		// Floating-point addition never wraps, so this only triggers for
+		// integers.
+		if (v > 0 && next < sum) || (v < 0 && next > sum) {
+			return 0, ErrOverflow
+		}
+		sum = next
+	}
+	return sum, nil
+}
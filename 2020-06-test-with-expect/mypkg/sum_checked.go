@@ -0,0 +1,15 @@
+package mypkg
+
+import (
+	"errors"
+)
+
+// ErrOverflow is returned when an integer accumulation wraps around.
+var ErrOverflow = errors.New("integer overflow")
+
+// SumChecked returns the sum of s, or ErrOverflow if the running sum
+// overflows at any point. Note that an intermediate overflow is reported even
+// if later values would have brought the sum back into range.
+func SumChecked(s []int64) (int64, error) {
+	return SumOf(s)
+}
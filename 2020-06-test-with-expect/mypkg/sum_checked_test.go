@@ -0,0 +1,51 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2020-06-test-with-expect/mypkg"
+)
+
+func TestSumChecked(t *testing.T) {
+	t.Run("With non-empty int64 slice", func(t *testing.T) {
+		s := []int64{1, 2, 3}
+		i, err := mypkg.SumChecked(s)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(i).DeepEqual(int64(6)))
+		t.Run("Expect input is unchanged", subtest.Value(s).DeepEqual([]int64{1, 2, 3}))
+	})
+	t.Run("With empty int64 slice", func(t *testing.T) {
+		i, err := mypkg.SumChecked([]int64{})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect zero", subtest.Value(i).DeepEqual(int64(0)))
+	})
+	t.Run("With sum equal to MaxInt64", func(t *testing.T) {
+		i, err := mypkg.SumChecked([]int64{math.MaxInt64 - 1, 1})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect MaxInt64", subtest.Value(i).DeepEqual(int64(math.MaxInt64)))
+	})
+	t.Run("With sum exceeding MaxInt64", func(t *testing.T) {
+		_, err := mypkg.SumChecked([]int64{math.MaxInt64, 1})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With sum equal to MinInt64", func(t *testing.T) {
+		i, err := mypkg.SumChecked([]int64{math.MinInt64 + 1, -1})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect MinInt64", subtest.Value(i).DeepEqual(int64(math.MinInt64)))
+	})
+	t.Run("With sum below MinInt64", func(t *testing.T) {
+		_, err := mypkg.SumChecked([]int64{math.MinInt64, -1})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With opposite extremes", func(t *testing.T) {
+		i, err := mypkg.SumChecked([]int64{math.MaxInt64, math.MinInt64})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect minus one", subtest.Value(i).DeepEqual(int64(-1)))
+	})
+	t.Run("With intermediate overflow", func(t *testing.T) {
+		_, err := mypkg.SumChecked([]int64{math.MaxInt64, 1, -1})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+}
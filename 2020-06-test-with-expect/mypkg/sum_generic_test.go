@@ -0,0 +1,33 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2020-06-test-with-expect/mypkg"
+)
+
+func TestSumOf(t *testing.T) {
+	t.Run("With non-empty int slice", func(t *testing.T) {
+		s := []int{1, 2, 3}
+		i, err := mypkg.SumOf(s)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum of the same type", subtest.Value(i).DeepEqual(6))
+		t.Run("Expect input is unchanged", subtest.Value(s).DeepEqual([]int{1, 2, 3}))
+	})
+	t.Run("With non-empty float32 slice", func(t *testing.T) {
+		f, err := mypkg.SumOf([]float32{0.5, 1.5, -1})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum of the same type", subtest.Value(f).DeepEqual(float32(1)))
+	})
+	t.Run("With overflowing uint8 slice", func(t *testing.T) {
+		_, err := mypkg.SumOf([]uint8{200, 100})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With float64 slice exceeding MaxFloat64", func(t *testing.T) {
+		f, err := mypkg.SumOf([]float64{math.MaxFloat64, math.MaxFloat64})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect positive infinity", subtest.Value(math.IsInf(f, 1)).DeepEqual(true))
+	})
+}
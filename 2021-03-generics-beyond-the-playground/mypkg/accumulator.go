@@ -0,0 +1,103 @@
+package mypkg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Accumulator sums vectors incrementally, e.g. as they arrive from a stream,
+// without keeping them in memory. The length of the first added vector locks
+// the dimension until Reset is called. The zero value is ready to use.
+type Accumulator[T Number] struct {
+	sum   Vector[T]
+	added bool
+}
+
+// Add adds v to the running sum; a DimensionError is returned if v has a
+// different length then the first added vector.
+func (a *Accumulator[T]) Add(v Vector[T]) error {
+	if !a.added {
+		a.sum = make(Vector[T], len(v))
+		a.added = true
+	}
+	if len(v) != len(a.sum) {
+		return DimensionError{Index: 0, Want: len(a.sum), Got: len(v)}
+	}
+	for i, x := range v {
+		a.sum[i] += x
+	}
+	return nil
+}
+
+// Result returns a copy of the current sum, or nil if no vectors have been
+// added.
+func (a *Accumulator[T]) Result() Vector[T] {
+	if !a.added {
+		return nil
+	}
+	target := make(Vector[T], len(a.sum))
+	copy(target, a.sum)
+	return target
+}
+
+// Reset clears the running sum and unlocks the dimension.
+func (a *Accumulator[T]) Reset() {
+	a.sum = nil
+	a.added = false
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, so that the running sum
+// can survive a process restart. The state is encoded as a single byte set to
+// 1 if a vector has been added, followed by the sum in the format written by
+// Vector.WriteTo. An error is returned as for WriteTo.
+func (a *Accumulator[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if !a.added {
+		buf.WriteByte(0)
+		return buf.Bytes(), nil
+	}
+	buf.WriteByte(1)
+	if _, err := a.sum.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, restoring state
+// written by MarshalBinary. Elements are checked as by ReadVectorFrom. On
+// error, a is left unchanged.
+func (a *Accumulator[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("accumulator state: no data")
+	}
+	switch data[0] {
+	case 0:
+		if len(data) > 1 {
+			return fmt.Errorf("accumulator state: %d bytes of trailing data", len(data)-1)
+		}
+		a.Reset()
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("accumulator state: invalid header byte %d", data[0])
+	}
+	r := bytes.NewReader(data[1:])
+	s, err := ReadVectorFrom[float64](r)
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return fmt.Errorf("accumulator state: %w", err)
+	}
+	if r.Len() > 0 {
+		return fmt.Errorf("accumulator state: %d bytes of trailing data", r.Len())
+	}
+	sum, err := fromFloat64s[T](s)
+	if err != nil {
+		return fmt.Errorf("accumulator state: %w", err)
+	}
+	a.sum, a.added = sum, true
+	return nil
+}
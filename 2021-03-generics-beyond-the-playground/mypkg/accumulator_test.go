@@ -0,0 +1,109 @@
+package mypkg_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestAccumulator(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		err1 := acc.Add(mypkg.Vector[float64]{1, 0, 3})
+		err2 := acc.Add(mypkg.Vector[float64]{0, 1, -2})
+		t.Run("Expect no errors", subtest.Value([]error{err1, err2}).DeepEqual([]error{nil, nil}))
+		t.Run("Expect correct sum", subtest.Value(acc.Result()).DeepEqual(mypkg.Vector[float64]{1, 1, 1}))
+	})
+	t.Run("With a vector of different length then the first", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1, 0, 3})
+		err := acc.Add(mypkg.Vector[float64]{1})
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 0, Want: 3, Got: 1}))
+		t.Run("Expect sum to be unchanged", subtest.Value(acc.Result()).DeepEqual(mypkg.Vector[float64]{1, 0, 3}))
+	})
+	t.Run("With no added vectors", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		t.Run("Expect nil result", subtest.Value(acc.Result()).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+	t.Run("When modifying the result", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1, 2})
+		acc.Result()[0] = 42
+		t.Run("Expect sum to be unchanged", subtest.Value(acc.Result()).DeepEqual(mypkg.Vector[float64]{1, 2}))
+	})
+	t.Run("When calling Reset", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1, 2})
+		acc.Reset()
+		err := acc.Add(mypkg.Vector[float64]{1, 2, 3})
+		t.Run("Expect dimension to be unlocked", subtest.Value(err).NoError())
+		t.Run("Expect sum to start over", subtest.Value(acc.Result()).DeepEqual(mypkg.Vector[float64]{1, 2, 3}))
+	})
+}
+
+func TestAccumulatorBinary(t *testing.T) {
+	t.Run("With added vectors", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1, 0, 3})
+		data, err := acc.MarshalBinary()
+		t.Run("Expect no marshal error", subtest.Value(err).NoError())
+
+		var restored mypkg.Accumulator[float64]
+		err = restored.UnmarshalBinary(data)
+		t.Run("Expect no unmarshal error", subtest.Value(err).NoError())
+		t.Run("Expect sum to be restored", subtest.Value(restored.Result()).DeepEqual(mypkg.Vector[float64]{1, 0, 3}))
+		t.Run("Expect dimension to stay locked", subtest.Value(restored.Add(mypkg.Vector[float64]{1})).DeepEqual(
+			mypkg.DimensionError{Index: 0, Want: 3, Got: 1},
+		))
+	})
+	t.Run("With int vectors", func(t *testing.T) {
+		var acc mypkg.Accumulator[int]
+		_ = acc.Add(mypkg.Vector[int]{1, -2})
+		data, _ := acc.MarshalBinary()
+		var restored mypkg.Accumulator[int]
+		err := restored.UnmarshalBinary(data)
+		t.Run("Expect no unmarshal error", subtest.Value(err).NoError())
+		t.Run("Expect sum to be restored", subtest.Value(restored.Result()).DeepEqual(mypkg.Vector[int]{1, -2}))
+	})
+	t.Run("With no added vectors", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		data, _ := acc.MarshalBinary()
+		restored := mypkg.Accumulator[float64]{}
+		_ = restored.Add(mypkg.Vector[float64]{1})
+		err := restored.UnmarshalBinary(data)
+		t.Run("Expect no unmarshal error", subtest.Value(err).NoError())
+		t.Run("Expect nil result", subtest.Value(restored.Result()).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+	t.Run("With a fraction decoded into an int accumulator", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1.5})
+		data, _ := acc.MarshalBinary()
+		var restored mypkg.Accumulator[int]
+		err := restored.UnmarshalBinary(data)
+		t.Run("Expect descriptive error", subtest.Value(err).MatchPattern(`^accumulator state: vector element 0: 1.5 is not representable as int$`))
+	})
+	t.Run("With truncated data", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1, 2})
+		data, _ := acc.MarshalBinary()
+		var restored mypkg.Accumulator[float64]
+		err := restored.UnmarshalBinary(data[:len(data)-1])
+		t.Run("Expect io.ErrUnexpectedEOF", subtest.Value(err).ErrorIs(io.ErrUnexpectedEOF))
+	})
+	t.Run("With trailing data", func(t *testing.T) {
+		var acc mypkg.Accumulator[float64]
+		_ = acc.Add(mypkg.Vector[float64]{1})
+		data, _ := acc.MarshalBinary()
+		var restored mypkg.Accumulator[float64]
+		err := restored.UnmarshalBinary(append(data, 0))
+		t.Run("Expect descriptive error", subtest.Value(err).MatchPattern(`^accumulator state: 1 bytes of trailing data$`))
+	})
+	t.Run("With complex vectors", func(t *testing.T) {
+		var acc mypkg.Accumulator[complex128]
+		_ = acc.Add(mypkg.Vector[complex128]{1i})
+		_, err := acc.MarshalBinary()
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
@@ -0,0 +1,97 @@
+package mypkg
+
+import (
+	"fmt"
+	"math"
+)
+
+// Assign solves the assignment problem for cost, using the Hungarian
+// algorithm. Row i is assigned to column assignment[i], so that no column is
+// used twice and the total cost of the assigned cells is minimal. When cost has
+// more rows than columns, the rows that are left unassigned get -1. An error
+// is returned if cost holds NaN or infinite values.
+func Assign(cost Matrix[float64]) (assignment []int, total float64, err error) {
+	for ij, c := range cost.Cells() {
+		if math.IsNaN(c) || math.IsInf(c, 0) {
+			return nil, 0, fmt.Errorf("cost at (%d, %d): %v is not finite", ij.Row, ij.Col, c)
+		}
+	}
+	if cost.rows > cost.cols {
+		colAssignment := hungarian(cost.Transpose())
+		assignment = make([]int, cost.rows)
+		for i := range assignment {
+			assignment[i] = -1
+		}
+		for j, i := range colAssignment {
+			assignment[i] = j
+		}
+	} else {
+		assignment = hungarian(cost)
+	}
+	for i, j := range assignment {
+		if j >= 0 {
+			total += cost.At(i, j)
+		}
+	}
+	return assignment, total, nil
+}
+
+// hungarian returns the minimal cost assignment of each row in cost to a
+// distinct column. It requires cost.rows <= cost.cols.
+//
+// The implementation keeps a potential u for each row and v for each column,
+// and adds one row at a time by finding a shortest augmenting path over the
+// reduced costs cost(i, j) - u[i] - v[j]. Index 0 of u, v, match and way is a
+// sentinel, so rows and columns are numbered from 1 internally.
+func hungarian(cost Matrix[float64]) []int {
+	n, m := cost.rows, cost.cols
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	match := make([]int, m+1) // The row matched to each column, or 0.
+	way := make([]int, m+1)   // The previous column on the augmenting path.
+	minv := make([]float64, m+1)
+	used := make([]bool, m+1)
+	for i := 1; i <= n; i++ {
+		match[0] = i
+		j0 := 0
+		for j := range minv {
+			minv[j], used[j] = math.Inf(1), false
+		}
+		for match[j0] != 0 {
+			used[j0] = true
+			i0, delta, j1 := match[j0], math.Inf(1), 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				if cur := cost.At(i0-1, j-1) - u[i0] - v[j]; cur < minv[j] {
+					minv[j], way[j] = cur, j0
+				}
+				if minv[j] < delta {
+					delta, j1 = minv[j], j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[match[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+		}
+		for j0 != 0 {
+			j1 := way[j0]
+			match[j0] = match[j1]
+			j0 = j1
+		}
+	}
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if match[j] != 0 {
+			assignment[match[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
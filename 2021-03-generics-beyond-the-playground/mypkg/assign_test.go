@@ -0,0 +1,98 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestAssign(t *testing.T) {
+	t.Run("With a square cost matrix", func(t *testing.T) {
+		cost := mustMatrix(t,
+			mypkg.Vector[float64]{4, 1, 3},
+			mypkg.Vector[float64]{2, 0, 5},
+			mypkg.Vector[float64]{3, 2, 2},
+		)
+		assignment, total, err := mypkg.Assign(cost)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect optimal assignment", subtest.Value(assignment).DeepEqual([]int{1, 0, 2}))
+		t.Run("Expect minimal total", subtest.Value(total).NumericEqual(5))
+	})
+	t.Run("With negative costs", func(t *testing.T) {
+		cost := mustMatrix(t,
+			mypkg.Vector[float64]{-1, -5},
+			mypkg.Vector[float64]{-4, -6},
+		)
+		assignment, total, err := mypkg.Assign(cost)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect optimal assignment", subtest.Value(assignment).DeepEqual([]int{1, 0}))
+		t.Run("Expect minimal total", subtest.Value(total).NumericEqual(-9))
+	})
+	t.Run("With more columns than rows", func(t *testing.T) {
+		cost := mustMatrix(t,
+			mypkg.Vector[float64]{5, 1, 9},
+			mypkg.Vector[float64]{2, 1, 8},
+		)
+		assignment, total, err := mypkg.Assign(cost)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect each row to be assigned", subtest.Value(assignment).DeepEqual([]int{1, 0}))
+		t.Run("Expect minimal total", subtest.Value(total).NumericEqual(3))
+	})
+	t.Run("With more rows than columns", func(t *testing.T) {
+		cost := mustMatrix(t,
+			mypkg.Vector[float64]{5, 2},
+			mypkg.Vector[float64]{1, 1},
+			mypkg.Vector[float64]{9, 8},
+		)
+		assignment, total, err := mypkg.Assign(cost)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect unassigned row to get -1", subtest.Value(assignment).DeepEqual([]int{1, 0, -1}))
+		t.Run("Expect minimal total", subtest.Value(total).NumericEqual(3))
+	})
+	t.Run("With an empty cost matrix", func(t *testing.T) {
+		assignment, total, err := mypkg.Assign(mypkg.Matrix[float64]{})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect empty assignment", subtest.Value(assignment).DeepEqual([]int{}))
+		t.Run("Expect zero total", subtest.Value(total).NumericEqual(0))
+	})
+	t.Run("With a NaN cost", func(t *testing.T) {
+		cost := mustMatrix(t, mypkg.Vector[float64]{1, math.NaN()})
+		_, _, err := mypkg.Assign(cost)
+		t.Run("Expect error with cell index", subtest.Value(err).MatchPattern(`^cost at \(0, 1\): NaN is not finite$`))
+	})
+}
+
+func TestAssign_bruteForce(t *testing.T) {
+	cost := mustMatrix(t,
+		mypkg.Vector[float64]{7, 53, 183, 439},
+		mypkg.Vector[float64]{497, 383, 563, 79},
+		mypkg.Vector[float64]{627, 343, 773, 959},
+		mypkg.Vector[float64]{447, 283, 463, 29},
+	)
+	want := math.Inf(1)
+	permute([]int{0, 1, 2, 3}, 0, func(p []int) {
+		var sum float64
+		for i, j := range p {
+			sum += cost.At(i, j)
+		}
+		want = min(want, sum)
+	})
+	_, total, err := mypkg.Assign(cost)
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect same total as brute force", subtest.Value(total).NumericEqual(want))
+}
+
+// permute calls f with every permutation of p[k:].
+func permute(p []int, k int, f func([]int)) {
+	if k == len(p) {
+		f(p)
+		return
+	}
+	for i := k; i < len(p); i++ {
+		p[k], p[i] = p[i], p[k]
+		permute(p, k+1, f)
+		p[k], p[i] = p[i], p[k]
+	}
+}
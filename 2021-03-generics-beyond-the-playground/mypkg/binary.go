@@ -0,0 +1,167 @@
+package mypkg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+)
+
+// binaryChunk is the number of elements encoded or decoded per read or write
+// call. It also bounds the up-front allocation when decoding, so that a
+// corrupt length prefix can't trigger a huge allocation.
+const binaryChunk = 512
+
+// WriteTo implements io.WriterTo. The vector is encoded as its length as a
+// little-endian uint64, followed by each element as a little-endian IEEE 754
+// float64. An error is returned without writing anything if an integer
+// element can't be represented exactly as a float64 (see ConvertExact), and for
+// complex vectors.
+func (v Vector[T]) WriteTo(w io.Writer) (int64, error) {
+	switch s := any([]T(v)).(type) {
+	case []float64:
+		return writeFloats(w, s)
+	case []float32:
+		return writeFloats(w, s)
+	}
+	s, err := v.exactFloat64s()
+	if err != nil {
+		return 0, err
+	}
+	return writeFloats(w, s)
+}
+
+// exactFloat64s converts v to float64 values, returning an error if any
+// element can't be represented exactly.
+func (v Vector[T]) exactFloat64s() ([]float64, error) {
+	if isComplex[T]() {
+		return nil, fmt.Errorf("binary encoding not supported for %T", v)
+	}
+	s := make([]float64, len(v))
+	rv := reflect.ValueOf(v)
+	for i := range v {
+		var ok bool
+		switch x := rv.Index(i); x.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			s[i], ok = exactFloat[float64](x.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			s[i], ok = exactFloat[float64](x.Uint())
+		default:
+			s[i], ok = x.Float(), true
+		}
+		if !ok {
+			return nil, fmt.Errorf("vector element %d: %v cannot be represented exactly as float64", i, rv.Index(i))
+		}
+	}
+	return s, nil
+}
+
+func writeFloats[F Float](w io.Writer, s []F) (int64, error) {
+	var n int64
+	buf := make([]byte, 8*binaryChunk)
+	binary.LittleEndian.PutUint64(buf, uint64(len(s)))
+	m, err := w.Write(buf[:8])
+	n += int64(m)
+	if err != nil {
+		return n, err
+	}
+	for start := 0; start < len(s); start += binaryChunk {
+		end := min(start+binaryChunk, len(s))
+		for i, x := range s[start:end] {
+			binary.LittleEndian.PutUint64(buf[8*i:], math.Float64bits(float64(x)))
+		}
+		m, err := w.Write(buf[:8*(end-start)])
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// ReadVectorFrom decodes a single vector in the format written by
+// Vector.WriteTo. Values that would change when converted to T are rejected
+// with an error, e.g. fractions or out of range values for integer types, or
+// 0.1 or 1e300 for float32. NaN is accepted for floating point types. If r holds no more
+// data, io.EOF is returned, so that a stream of vectors can be read until
+// io.EOF. A vector that is cut short returns io.ErrUnexpectedEOF.
+func ReadVectorFrom[T Real](r io.Reader) (Vector[T], error) {
+	buf := make([]byte, 8*binaryChunk)
+	if _, err := io.ReadFull(r, buf[:8]); err != nil {
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint64(buf)
+	if size > math.MaxInt {
+		return nil, fmt.Errorf("vector length %d too large", size)
+	}
+	n := int(size)
+	lo, hi, isInt := intRange[T]()
+	v := make(Vector[T], 0, min(n, binaryChunk))
+	for len(v) < n {
+		k := min(n-len(v), binaryChunk)
+		if _, err := io.ReadFull(r, buf[:8*k]); err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, fmt.Errorf("vector element %d: %w", len(v), err)
+		}
+		for i := 0; i < k; i++ {
+			f := math.Float64frombits(binary.LittleEndian.Uint64(buf[8*i:]))
+			// Range is checked first for integers, as converting an out of
+			// range float64 to an integer type is implementation-defined.
+			if (isInt && !(f >= lo && f < hi)) || (f == f && float64(T(f)) != f) {
+				return nil, fmt.Errorf("vector element %d: %v is not representable as %T", len(v), f, T(0))
+			}
+			v = append(v, T(f))
+		}
+	}
+	return v, nil
+}
+
+// intRange reports whether T is an integer type and, if so, the range of
+// float64 values [lo, hi) that convert to T without overflow.
+func intRange[T Real]() (lo, hi float64, ok bool) {
+	return intRangeOf(reflect.TypeFor[T]())
+}
+
+func intRangeOf(t reflect.Type) (lo, hi float64, ok bool) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		hi = math.Ldexp(1, t.Bits()-1)
+		return -hi, hi, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return 0, math.Ldexp(1, t.Bits()), true
+	}
+	return 0, 0, false
+}
+
+// fromFloat64s converts decoded float64 values to a Vector[T], applying the
+// same checks as ReadVectorFrom. It serves callers where T may be complex, and
+// returns an error in that case.
+func fromFloat64s[T Number](s []float64) (Vector[T], error) {
+	v := make(Vector[T], len(s))
+	if isComplex[T]() {
+		return nil, fmt.Errorf("binary encoding not supported for %T", v)
+	}
+	rv := reflect.ValueOf(v)
+	lo, hi, isInt := intRangeOf(rv.Type().Elem())
+	for i, f := range s {
+		x := rv.Index(i)
+		switch {
+		case isInt && (!(f >= lo && f < hi) || f != math.Trunc(f)):
+			return nil, fmt.Errorf("vector element %d: %v is not representable as %T", i, f, v[i])
+		case !isInt:
+			x.SetFloat(f)
+			if f == f && x.Float() != f {
+				return nil, fmt.Errorf("vector element %d: %v is not representable as %T", i, f, v[i])
+			}
+		case x.CanInt():
+			x.SetInt(int64(f))
+		default:
+			x.SetUint(uint64(f))
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,168 @@
+package mypkg_test
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+var _ io.WriterTo = mypkg.Vector[float64]{}
+
+func TestVectorWriteTo(t *testing.T) {
+	t.Run("With a float64 vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		n, err := mypkg.Vector[float64]{1, -2.5}.WriteTo(&buf)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect bytes written to be reported", subtest.Value(n).NumericEqual(24))
+		t.Run("Expect little-endian encoding", subtest.Value(buf.Bytes()).DeepEqual([]byte{
+			2, 0, 0, 0, 0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0, 0xf0, 0x3f,
+			0, 0, 0, 0, 0, 0, 0x04, 0xc0,
+		}))
+	})
+	t.Run("With an int64 element of 2^53+1", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := mypkg.Vector[int64]{1, 1<<53 + 1}.WriteTo(&buf)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+		t.Run("Expect nothing written", subtest.Value(buf.Len()).NumericEqual(0))
+	})
+	t.Run("With exactly representable large integers", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err1 := mypkg.Vector[uint64]{1 << 53, 1 << 63}.WriteTo(&buf)
+		_, err2 := mypkg.Vector[int64]{1 << 54, math.MinInt64}.WriteTo(&buf)
+		t.Run("Expect no errors", subtest.Value([]error{err1, err2}).DeepEqual([]error{nil, nil}))
+	})
+	t.Run("With MaxInt64", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := mypkg.Vector[int64]{math.MaxInt64}.WriteTo(&buf)
+		t.Run("Expect error", subtest.Value(err).MatchPattern(`^vector element 0: 9223372036854775807 cannot be represented exactly as float64$`))
+	})
+	t.Run("With a complex vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		_, err := mypkg.Vector[complex128]{1i}.WriteTo(&buf)
+		t.Run("Expect error", subtest.Value(err).Error())
+		t.Run("Expect nothing written", subtest.Value(buf.Len()).NumericEqual(0))
+	})
+}
+
+func TestReadVectorFrom(t *testing.T) {
+	t.Run("With a stream of vectors", func(t *testing.T) {
+		long := make(mypkg.Vector[float64], 1500)
+		for i := range long {
+			long[i] = float64(i) / 3
+		}
+		var buf bytes.Buffer
+		for _, v := range []mypkg.Vector[float64]{{1, 2, 3}, {}, long} {
+			if _, err := v.WriteTo(&buf); err != nil {
+				t.Fatal(err)
+			}
+		}
+		v1, err1 := mypkg.ReadVectorFrom[float64](&buf)
+		v2, err2 := mypkg.ReadVectorFrom[float64](&buf)
+		v3, err3 := mypkg.ReadVectorFrom[float64](&buf)
+		_, err4 := mypkg.ReadVectorFrom[float64](&buf)
+		t.Run("Expect no errors", subtest.Value([]error{err1, err2, err3}).DeepEqual([]error{nil, nil, nil}))
+		t.Run("Expect first vector", subtest.Value(v1).DeepEqual(mypkg.Vector[float64]{1, 2, 3}))
+		t.Run("Expect empty second vector", subtest.Value(v2).DeepEqual(mypkg.Vector[float64]{}))
+		t.Run("Expect long third vector", subtest.Value(v3).DeepEqual(long))
+		t.Run("Expect io.EOF at end of stream", subtest.Value(err4).ErrorIs(io.EOF))
+	})
+	t.Run("With an int vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[int]{-3, 7}.WriteTo(&buf)
+		v, err := mypkg.ReadVectorFrom[int](&buf)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect round-trip", subtest.Value(v).DeepEqual(mypkg.Vector[int]{-3, 7}))
+	})
+	t.Run("With a fraction for an int vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[float64]{1, 1.5}.WriteTo(&buf)
+		_, err := mypkg.ReadVectorFrom[int](&buf)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: 1.5 is not representable as int$`))
+	})
+	t.Run("With NaN for an int vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[float64]{math.NaN()}.WriteTo(&buf)
+		_, err := mypkg.ReadVectorFrom[int64](&buf)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 0: NaN `))
+	})
+	t.Run("With out of range values for a uint8 vector", func(t *testing.T) {
+		for _, f := range []float64{-1, 256} {
+			var buf bytes.Buffer
+			mypkg.Vector[float64]{255, f}.WriteTo(&buf)
+			_, err := mypkg.ReadVectorFrom[uint8](&buf)
+			t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+		}
+	})
+	t.Run("With 2^63 for an int64 vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[float64]{-(1 << 63), 1 << 63}.WriteTo(&buf)
+		_, err := mypkg.ReadVectorFrom[int64](&buf)
+		t.Run("Expect error for the out of range element", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With a float32 vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[float32]{0.1, float32(math.Inf(-1)), float32(math.NaN())}.WriteTo(&buf)
+		v, err := mypkg.ReadVectorFrom[float32](&buf)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect exact values", subtest.Value(v[:2]).DeepEqual(mypkg.Vector[float32]{0.1, float32(math.Inf(-1))}))
+		t.Run("Expect NaN to be kept", subtest.Value(math.IsNaN(float64(v[2]))).DeepEqual(true))
+	})
+	t.Run("With float64 values that don't fit float32", func(t *testing.T) {
+		for _, f := range []float64{0.1, 1e300} {
+			var buf bytes.Buffer
+			mypkg.Vector[float64]{1, f}.WriteTo(&buf)
+			_, err := mypkg.ReadVectorFrom[float32](&buf)
+			t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: .* is not representable as float32$`))
+		}
+	})
+	t.Run("With a truncated vector", func(t *testing.T) {
+		var buf bytes.Buffer
+		mypkg.Vector[float64]{1, 2, 3}.WriteTo(&buf)
+		buf.Truncate(buf.Len() - 4)
+		_, err := mypkg.ReadVectorFrom[float64](&buf)
+		t.Run("Expect io.ErrUnexpectedEOF", subtest.Value(err).ErrorIs(io.ErrUnexpectedEOF))
+	})
+	t.Run("With a huge length prefix and no data", func(t *testing.T) {
+		b := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0f}
+		_, err := mypkg.ReadVectorFrom[float64](bytes.NewReader(b))
+		t.Run("Expect io.ErrUnexpectedEOF", subtest.Value(err).ErrorIs(io.ErrUnexpectedEOF))
+	})
+}
+
+func FuzzReadVectorFrom(f *testing.F) {
+	for _, v := range []mypkg.Vector[float64]{{}, {1, 2, 3}, {math.NaN(), math.Inf(-1)}} {
+		var buf bytes.Buffer
+		v.WriteTo(&buf)
+		f.Add(buf.Bytes())
+	}
+	f.Add([]byte{1, 0, 0})
+	f.Fuzz(func(t *testing.T, b []byte) {
+		r := bytes.NewReader(b)
+		v, err := mypkg.ReadVectorFrom[float64](r)
+		if err != nil {
+			return
+		}
+		consumed := b[:len(b)-r.Len()]
+		var buf bytes.Buffer
+		if _, err := v.WriteTo(&buf); err != nil {
+			t.Fatalf("re-encoding failed: %v", err)
+		}
+		if !bytes.Equal(buf.Bytes(), consumed) {
+			t.Errorf("re-encoding gave % x, want % x", buf.Bytes(), consumed)
+		}
+	})
+}
+
+func BenchmarkVectorWriteTo(b *testing.B) {
+	v := benchmarkVectors(1, 100000)[0]
+	b.ResetTimer()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = v.WriteTo(io.Discard)
+	}
+}
@@ -0,0 +1,87 @@
+package mypkg
+
+// BoolVector is a vector of booleans, typically used as a mask for selecting
+// elements from a Vector.
+type BoolVector []bool
+
+// And returns the element-wise logical AND of m and o; an error is returned if
+// the lengths differ.
+func (m BoolVector) And(o BoolVector) (BoolVector, error) {
+	if len(m) != len(o) {
+		return nil, DimensionError{Index: 1, Want: len(m), Got: len(o)}
+	}
+	target := make(BoolVector, len(m))
+	for i := range m {
+		target[i] = m[i] && o[i]
+	}
+	return target, nil
+}
+
+// Or returns the element-wise logical OR of m and o; an error is returned if
+// the lengths differ.
+func (m BoolVector) Or(o BoolVector) (BoolVector, error) {
+	if len(m) != len(o) {
+		return nil, DimensionError{Index: 1, Want: len(m), Got: len(o)}
+	}
+	target := make(BoolVector, len(m))
+	for i := range m {
+		target[i] = m[i] || o[i]
+	}
+	return target, nil
+}
+
+// Not returns the element-wise logical negation of m.
+func (m BoolVector) Not() BoolVector {
+	target := make(BoolVector, len(m))
+	for i := range m {
+		target[i] = !m[i]
+	}
+	return target
+}
+
+// Count returns the number of true elements in m.
+func (m BoolVector) Count() int {
+	var n int
+	for _, b := range m {
+		if b {
+			n++
+		}
+	}
+	return n
+}
+
+// Select returns the elements of v where mask is true, in order; an error is
+// returned if the lengths differ.
+func Select[T Number](v Vector[T], mask BoolVector) (Vector[T], error) {
+	if len(v) != len(mask) {
+		return nil, DimensionError{Index: 1, Want: len(v), Got: len(mask)}
+	}
+	target := make(Vector[T], 0, mask.Count())
+	for i, b := range mask {
+		if b {
+			target = append(target, v[i])
+		}
+	}
+	return target, nil
+}
+
+// Where returns a vector holding the elements of a where mask is true, and
+// the elements of b where it's false; an error is returned if the lengths
+// differ.
+func Where[T Number](mask BoolVector, a, b Vector[T]) (Vector[T], error) {
+	if len(a) != len(mask) {
+		return nil, DimensionError{Index: 1, Want: len(mask), Got: len(a)}
+	}
+	if len(b) != len(mask) {
+		return nil, DimensionError{Index: 2, Want: len(mask), Got: len(b)}
+	}
+	target := make(Vector[T], len(mask))
+	for i, m := range mask {
+		if m {
+			target[i] = a[i]
+		} else {
+			target[i] = b[i]
+		}
+	}
+	return target, nil
+}
@@ -0,0 +1,63 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestBoolVector(t *testing.T) {
+	m := mypkg.BoolVector{true, true, false, false}
+	o := mypkg.BoolVector{true, false, true, false}
+
+	t.Run("When calling And", func(t *testing.T) {
+		result, err := m.And(o)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct result", subtest.Value(result).DeepEqual(mypkg.BoolVector{true, false, false, false}))
+	})
+	t.Run("When calling Or", func(t *testing.T) {
+		result, err := m.Or(o)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct result", subtest.Value(result).DeepEqual(mypkg.BoolVector{true, true, true, false}))
+	})
+	t.Run("When calling Not", func(t *testing.T) {
+		result := m.Not()
+		t.Run("Expect correct result", subtest.Value(result).DeepEqual(mypkg.BoolVector{false, false, true, true}))
+		t.Run("Expect input is unchanged", subtest.Value(m).DeepEqual(mypkg.BoolVector{true, true, false, false}))
+	})
+	t.Run("When calling Count", func(t *testing.T) {
+		t.Run("Expect number of true elements", subtest.Value(m.Count()).NumericEqual(2))
+	})
+	t.Run("When calling And with unequal length", func(t *testing.T) {
+		_, err := m.And(mypkg.BoolVector{true})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestSelect(t *testing.T) {
+	t.Run("With matching mask", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 2, 3, 4}
+		result, err := mypkg.Select(v, mypkg.BoolVector{false, true, false, true})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect selected elements", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{2, 4}))
+	})
+	t.Run("With mask of unequal length", func(t *testing.T) {
+		_, err := mypkg.Select(mypkg.Vector[float64]{1, 2}, mypkg.BoolVector{true})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestWhere(t *testing.T) {
+	t.Run("With matching mask", func(t *testing.T) {
+		a := mypkg.Vector[float64]{1, 2, 3}
+		b := mypkg.Vector[float64]{-1, -2, -3}
+		result, err := mypkg.Where(mypkg.BoolVector{true, false, true}, a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect elements from a where true and b where false", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{1, -2, 3}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Where(mypkg.BoolVector{true, false}, mypkg.Vector[float64]{1, 2}, mypkg.Vector[float64]{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
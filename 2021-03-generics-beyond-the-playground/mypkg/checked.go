@@ -0,0 +1,115 @@
+package mypkg
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrOverflow is returned, wrapped with the index of the offending element,
+// when exact integer arithmetic would wrap around.
+var ErrOverflow = errors.New("integer overflow")
+
+// SumChecked returns the sum of multiple integer vectors of the same length,
+// like Sum, but returns an error wrapping ErrOverflow if any element of the
+// running sum overflows T.
+func SumChecked[T Integer](vectors ...Vector[T]) (Vector[T], error) {
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+	if err := checkLengths(vectors...); err != nil {
+		return nil, err
+	}
+	target := make(Vector[T], len(vectors[0]))
+	for _, v := range vectors {
+		for i, x := range v {
+			sum, ok := addChecked(target[i], x)
+			if !ok {
+				return nil, fmt.Errorf("vector element %d: %w", i, ErrOverflow)
+			}
+			target[i] = sum
+		}
+	}
+	return target, nil
+}
+
+// DotChecked returns the dot product of two integer vectors, like Dot, but
+// returns an error wrapping ErrOverflow if any product, or the running sum of
+// products, overflows T.
+func DotChecked[T Integer](a, b Vector[T]) (T, error) {
+	if err := checkLengths(a, b); err != nil {
+		return 0, err
+	}
+	var sum T
+	for i := range a {
+		p, ok := mulChecked(a[i], b[i])
+		if ok {
+			sum, ok = addChecked(sum, p)
+		}
+		if !ok {
+			return 0, fmt.Errorf("vector element %d: %w", i, ErrOverflow)
+		}
+	}
+	return sum, nil
+}
+
+func addChecked[T Integer](a, b T) (T, bool) {
+	sum := a + b
+	return sum, !((b > 0 && sum < a) || (b < 0 && sum > a))
+}
+
+func mulChecked[T Integer](a, b T) (T, bool) {
+	p := a * b
+	if a == 0 {
+		return p, true
+	}
+	// For signed types, -1 * min wraps back to min, which the division check
+	// can't catch since min / -1 also wraps to min.
+	if a < 0 && a == ^T(0) && b != 0 && p == b {
+		return p, false
+	}
+	return p, p/a == b
+}
+
+// ConvertExact converts an integer vector to a floating point vector. An error
+// is returned if any element can't be represented exactly by F, e.g. 2^53+1
+// for float64. It serves as the ToFloat conversion for integer vectors, as
+// there is no separate IntVector type.
+func ConvertExact[F Float, I Integer](v Vector[I]) (Vector[F], error) {
+	target := make(Vector[F], len(v))
+	for i, x := range v {
+		f, ok := exactFloat[F](x)
+		if !ok {
+			return nil, fmt.Errorf("vector element %d: %d cannot be represented exactly as %T", i, x, f)
+		}
+		target[i] = f
+	}
+	return target, nil
+}
+
+// exactFloat converts x to F, and reports whether the conversion is exact,
+// i.e. whether converting back gives x. Values that round up to the first
+// value out of range for I are checked before converting back, as that
+// conversion is implementation-defined.
+func exactFloat[F Float, I Integer](x I) (F, bool) {
+	_, hi, _ := intRange[I]()
+	f := F(x)
+	return f, float64(f) < hi && I(f) == x
+}
+
+// ConvertRound converts a floating point vector to an integer vector, using
+// round to map each element to an integral value, e.g. math.Round,
+// math.RoundToEven, math.Floor, math.Ceil or math.Trunc. An error is returned
+// if a rounded element is NaN or out of range for I.
+func ConvertRound[I Integer, F Float](v Vector[F], round func(float64) float64) (Vector[I], error) {
+	lo, hi, _ := intRange[I]()
+	target := make(Vector[I], len(v))
+	for i, x := range v {
+		f := round(float64(x))
+		if !(f >= lo && f < hi) || f != math.Trunc(f) {
+			return nil, fmt.Errorf("vector element %d: %v is not representable as %T", i, f, I(0))
+		}
+		target[i] = I(f)
+	}
+	return target, nil
+}
@@ -0,0 +1,103 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSumChecked(t *testing.T) {
+	t.Run("With sums in range", func(t *testing.T) {
+		result, err := mypkg.SumChecked(
+			mypkg.Vector[int64]{math.MaxInt64 - 1, math.MinInt64 + 1},
+			mypkg.Vector[int64]{1, -1},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect exact sum", subtest.Value(result).DeepEqual(mypkg.Vector[int64]{math.MaxInt64, math.MinInt64}))
+	})
+	t.Run("With an overflowing element", func(t *testing.T) {
+		_, err := mypkg.SumChecked(mypkg.Vector[int8]{1, 100}, mypkg.Vector[int8]{1, 28})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+		t.Run("Expect element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With an underflowing uint element", func(t *testing.T) {
+		_, err := mypkg.SumChecked(mypkg.Vector[uint8]{200}, mypkg.Vector[uint8]{100})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.SumChecked(mypkg.Vector[int]{1}, mypkg.Vector[int]{1, 2})
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 1, Want: 1, Got: 2}))
+	})
+}
+
+func TestDotChecked(t *testing.T) {
+	t.Run("With a product in range", func(t *testing.T) {
+		d, err := mypkg.DotChecked(mypkg.Vector[int8]{-8, 3}, mypkg.Vector[int8]{16, 1})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect exact result", subtest.Value(d).DeepEqual(int8(-125)))
+	})
+	t.Run("With an overflowing product", func(t *testing.T) {
+		_, err := mypkg.DotChecked(mypkg.Vector[int8]{100}, mypkg.Vector[int8]{2})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With -1 times the minimum value", func(t *testing.T) {
+		_, err1 := mypkg.DotChecked(mypkg.Vector[int8]{-1}, mypkg.Vector[int8]{math.MinInt8})
+		_, err2 := mypkg.DotChecked(mypkg.Vector[int8]{math.MinInt8}, mypkg.Vector[int8]{-1})
+		t.Run("Expect ErrOverflow for -1 * min", subtest.Value(err1).ErrorIs(mypkg.ErrOverflow))
+		t.Run("Expect ErrOverflow for min * -1", subtest.Value(err2).ErrorIs(mypkg.ErrOverflow))
+	})
+	t.Run("With an overflowing sum of products", func(t *testing.T) {
+		_, err := mypkg.DotChecked(mypkg.Vector[uint8]{10, 10, 10}, mypkg.Vector[uint8]{10, 10, 6})
+		t.Run("Expect ErrOverflow", subtest.Value(err).ErrorIs(mypkg.ErrOverflow))
+		t.Run("Expect element index", subtest.Value(err).MatchPattern(`^vector element 2: `))
+	})
+}
+
+func TestConvertExact(t *testing.T) {
+	t.Run("With exactly representable values", func(t *testing.T) {
+		v, err := mypkg.ConvertExact[float64](mypkg.Vector[int64]{-1 << 53, 3, 1 << 53, 1 << 54})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect exact conversion", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{-1 << 53, 3, 1 << 53, 1 << 54}))
+	})
+	t.Run("With a value of 2^53+1", func(t *testing.T) {
+		_, err := mypkg.ConvertExact[float64](mypkg.Vector[int64]{0, 1<<53 + 1})
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With MaxInt64", func(t *testing.T) {
+		_, err := mypkg.ConvertExact[float64](mypkg.Vector[int64]{math.MaxInt64})
+		t.Run("Expect error", subtest.Value(err).Error())
+	})
+	t.Run("With a value beyond float32 precision", func(t *testing.T) {
+		_, err := mypkg.ConvertExact[float32](mypkg.Vector[int32]{1<<24 + 1})
+		t.Run("Expect error", subtest.Value(err).Error())
+	})
+}
+
+func TestConvertRound(t *testing.T) {
+	v := mypkg.Vector[float64]{-1.5, 0.5, 2.5}
+	t.Run("With math.Round", func(t *testing.T) {
+		r, err := mypkg.ConvertRound[int](v, math.Round)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect halves rounded away from zero", subtest.Value(r).DeepEqual(mypkg.Vector[int]{-2, 1, 3}))
+	})
+	t.Run("With math.RoundToEven", func(t *testing.T) {
+		r, err := mypkg.ConvertRound[int](v, math.RoundToEven)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect halves rounded to even", subtest.Value(r).DeepEqual(mypkg.Vector[int]{-2, 0, 2}))
+	})
+	t.Run("With math.Floor", func(t *testing.T) {
+		r, err := mypkg.ConvertRound[int](v, math.Floor)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect values rounded down", subtest.Value(r).DeepEqual(mypkg.Vector[int]{-2, 0, 2}))
+	})
+	t.Run("With an out of range value", func(t *testing.T) {
+		_, err := mypkg.ConvertRound[uint8](mypkg.Vector[float64]{255.4, 255.6}, math.Round)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: 256 is not representable as uint8$`))
+	})
+	t.Run("With NaN", func(t *testing.T) {
+		_, err := mypkg.ConvertRound[int64](mypkg.Vector[float64]{math.NaN()}, math.Round)
+		t.Run("Expect error", subtest.Value(err).Error())
+	})
+}
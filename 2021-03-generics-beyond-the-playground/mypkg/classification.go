@@ -0,0 +1,147 @@
+package mypkg
+
+import "fmt"
+
+// Average selects how per-class scores are combined by Precision, Recall and
+// F1.
+type Average int
+
+const (
+	// Macro averages the per-class scores, weighting each class equally.
+	// Only classes that occur in pred or truth are included.
+	Macro Average = iota
+	// Micro computes the score from the total counts over all classes. For
+	// single-label classification, this equals Accuracy for all three
+	// scores.
+	Micro
+)
+
+// MaxClasses is the number of classes supported by ConfusionMatrix and the
+// scores built on it. It bounds the k×k matrix allocated for the labels.
+const MaxClasses = 1024
+
+// ConfusionMatrix returns a matrix counting how often each class in truth was
+// predicted as each class in pred. Row i holds the samples whose true class is
+// i, and column j the samples predicted as class j. Classes must be labeled
+// 0 to k-1, where k is one more than the largest label and at most MaxClasses.
+// An error is returned if pred and truth are empty, have different lengths, or
+// hold a label outside [0, MaxClasses).
+func ConfusionMatrix(pred, truth []int) (Matrix[int], error) {
+	if err := checkMetricInput(Vector[int](pred), Vector[int](truth)); err != nil {
+		return Matrix[int]{}, err
+	}
+	k := 0
+	for i := range pred {
+		for _, label := range [2]int{pred[i], truth[i]} {
+			if label < 0 || label >= MaxClasses {
+				return Matrix[int]{}, fmt.Errorf("sample %d: class label %d out of range [0:%d]", i, label, MaxClasses)
+			}
+		}
+		k = max(k, pred[i]+1, truth[i]+1)
+	}
+	m := newMatrix[int](k, k)
+	for i := range pred {
+		m.Set(truth[i], pred[i], m.At(truth[i], pred[i])+1)
+	}
+	return m, nil
+}
+
+// Accuracy returns the fraction of samples where pred equals truth; an error
+// is returned if the slices are empty or have different lengths.
+func Accuracy(pred, truth []int) (float64, error) {
+	if err := checkMetricInput(Vector[int](pred), Vector[int](truth)); err != nil {
+		return 0, err
+	}
+	var correct int
+	for i := range pred {
+		if pred[i] == truth[i] {
+			correct++
+		}
+	}
+	return float64(correct) / float64(len(pred)), nil
+}
+
+// Precision returns the fraction of predictions of a class that are correct,
+// averaged over classes as selected by avg. A class that is never predicted
+// has a precision of 0. Errors are returned as for ConfusionMatrix.
+func Precision(pred, truth []int, avg Average) (float64, error) {
+	return classScore(pred, truth, avg, func(c classCounts) float64 {
+		return c.precision()
+	})
+}
+
+// Recall returns the fraction of samples of a class that are predicted
+// correctly, averaged over classes as selected by avg. A class that never
+// occurs in truth has a recall of 0. Errors are returned as for
+// ConfusionMatrix.
+func Recall(pred, truth []int, avg Average) (float64, error) {
+	return classScore(pred, truth, avg, func(c classCounts) float64 {
+		return c.recall()
+	})
+}
+
+// F1 returns the harmonic mean of precision and recall, averaged over classes
+// as selected by avg. For Macro, the per-class F1 scores are averaged. Errors
+// are returned as for ConfusionMatrix.
+func F1(pred, truth []int, avg Average) (float64, error) {
+	return classScore(pred, truth, avg, func(c classCounts) float64 {
+		p, r := c.precision(), c.recall()
+		if p+r == 0 {
+			return 0
+		}
+		return 2 * p * r / (p + r)
+	})
+}
+
+// classCounts holds the true positives of a class, and the number of times it
+// was predicted and occurred in truth.
+type classCounts struct {
+	tp, predicted, actual int
+}
+
+func (c classCounts) precision() float64 {
+	if c.predicted == 0 {
+		return 0
+	}
+	return float64(c.tp) / float64(c.predicted)
+}
+
+func (c classCounts) recall() float64 {
+	if c.actual == 0 {
+		return 0
+	}
+	return float64(c.tp) / float64(c.actual)
+}
+
+func classScore(pred, truth []int, avg Average, score func(classCounts) float64) (float64, error) {
+	m, err := ConfusionMatrix(pred, truth)
+	if err != nil {
+		return 0, err
+	}
+	counts := make([]classCounts, m.Rows())
+	var total classCounts
+	for i := range counts {
+		for j := range counts {
+			counts[i].actual += m.At(i, j)
+			counts[j].predicted += m.At(i, j)
+		}
+		counts[i].tp = m.At(i, i)
+		total.tp += counts[i].tp
+	}
+	switch avg {
+	case Micro:
+		total.predicted, total.actual = len(pred), len(truth)
+		return score(total), nil
+	case Macro:
+		var sum float64
+		var n int
+		for _, c := range counts {
+			if c.predicted+c.actual > 0 {
+				sum += score(c)
+				n++
+			}
+		}
+		return sum / float64(n), nil
+	}
+	return 0, fmt.Errorf("unknown average %d", avg)
+}
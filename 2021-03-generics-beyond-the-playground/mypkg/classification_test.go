@@ -0,0 +1,84 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+// Three-class example, with class 2 never predicted:
+//
+//	truth: 0 0 1 1 1 2
+//	pred:  0 1 1 1 0 1
+var (
+	classPred  = []int{0, 1, 1, 1, 0, 1}
+	classTruth = []int{0, 0, 1, 1, 1, 2}
+)
+
+func TestConfusionMatrix(t *testing.T) {
+	t.Run("With three classes", func(t *testing.T) {
+		m, err := mypkg.ConfusionMatrix(classPred, classTruth)
+		want, _ := mypkg.MatrixFromRows(
+			mypkg.Vector[int]{1, 1, 0},
+			mypkg.Vector[int]{1, 2, 0},
+			mypkg.Vector[int]{0, 1, 0},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect counts by true row and predicted column", subtest.Value(m).DeepEqual(want))
+	})
+	t.Run("With a negative label", func(t *testing.T) {
+		_, err := mypkg.ConfusionMatrix([]int{0, -1}, []int{0, 0})
+		t.Run("Expect error with sample index", subtest.Value(err).MatchPattern(`^sample 1: class label -1 out of range \[0:1024\]$`))
+	})
+	t.Run("With a label of MaxClasses", func(t *testing.T) {
+		_, err := mypkg.ConfusionMatrix([]int{0, 0}, []int{0, mypkg.MaxClasses})
+		t.Run("Expect error with sample index", subtest.Value(err).MatchPattern(`^sample 1: class label 1024 out of range \[0:1024\]$`))
+	})
+	t.Run("With slices of unequal length", func(t *testing.T) {
+		_, err := mypkg.ConfusionMatrix([]int{0, 1}, []int{0})
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 1, Want: 2, Got: 1}))
+	})
+	t.Run("With empty slices", func(t *testing.T) {
+		_, err := mypkg.ConfusionMatrix(nil, nil)
+		t.Run("Expect ErrEmpty", subtest.Value(err).ErrorIs(mypkg.ErrEmpty))
+	})
+}
+
+func TestAccuracy(t *testing.T) {
+	a, err := mypkg.Accuracy(classPred, classTruth)
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect fraction of correct predictions", subtest.Value(a).NumericEqual(0.5))
+}
+
+func TestClassificationScores(t *testing.T) {
+	// Per class: precision 1/2, 2/4, 0; recall 1/2, 2/3, 0.
+	p0, p1 := 0.5, 0.5
+	r0, r1 := 0.5, 2.0/3
+	f0, f1 := 2*p0*r0/(p0+r0), 2*p1*r1/(p1+r1)
+	t.Run("With macro average", func(t *testing.T) {
+		p, errP := mypkg.Precision(classPred, classTruth, mypkg.Macro)
+		r, errR := mypkg.Recall(classPred, classTruth, mypkg.Macro)
+		f, errF := mypkg.F1(classPred, classTruth, mypkg.Macro)
+		t.Run("Expect no errors", subtest.Value([]error{errP, errR, errF}).DeepEqual([]error{nil, nil, nil}))
+		t.Run("Expect mean precision", subtest.Value(p).NumericEqual((p0+p1)/3))
+		t.Run("Expect mean recall", subtest.Value(r).NumericEqual((r0+r1)/3))
+		t.Run("Expect mean F1", subtest.Value(f).NumericEqual((f0+f1)/3))
+	})
+	t.Run("With micro average", func(t *testing.T) {
+		p, errP := mypkg.Precision(classPred, classTruth, mypkg.Micro)
+		r, errR := mypkg.Recall(classPred, classTruth, mypkg.Micro)
+		f, errF := mypkg.F1(classPred, classTruth, mypkg.Micro)
+		t.Run("Expect no errors", subtest.Value([]error{errP, errR, errF}).DeepEqual([]error{nil, nil, nil}))
+		t.Run("Expect scores equal to accuracy", subtest.Value([]float64{p, r, f}).DeepEqual([]float64{0.5, 0.5, 0.5}))
+	})
+	t.Run("With unused labels", func(t *testing.T) {
+		p, err := mypkg.Precision([]int{0, 3}, []int{0, 3}, mypkg.Macro)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect unused classes to be ignored", subtest.Value(p).NumericEqual(1))
+	})
+	t.Run("With an unknown average", func(t *testing.T) {
+		_, err := mypkg.F1(classPred, classTruth, mypkg.Average(7))
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
@@ -0,0 +1,115 @@
+// Command vecsum reads vectors from files or stdin, sums them using mypkg and
+// prints the result.
+//
+// Usage:
+//
+//	vecsum [-format csv|ndjson] [-workers n] [-compensated] [file ...]
+//
+// Input is read from stdin when no files are given. Each CSV row, or each
+// line of JSON array for ndjson, is one vector. The result is printed in the
+// same format as the input.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command and returns its exit code: 0 on success, 1 on errors,
+// and 2 on usage errors, following the conventions of the flag package.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("vecsum", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	format := fs.String("format", "csv", "input and output `format`: csv or ndjson")
+	workers := fs.Int("workers", 1, "number of goroutines to sum with; 0 means GOMAXPROCS")
+	compensated := fs.Bool("compensated", false, "use compensated summation to reduce rounding error")
+	usageError := func(msg string) int {
+		fmt.Fprintln(stderr, msg)
+		fs.Usage()
+		return 2
+	}
+	if err := fs.Parse(args); errors.Is(err, flag.ErrHelp) {
+		return 0
+	} else if err != nil {
+		// The flag package has already printed the error and usage.
+		return 2
+	}
+	if *compensated && *workers != 1 {
+		return usageError("-compensated cannot be combined with -workers")
+	}
+	if *format != "csv" && *format != "ndjson" {
+		return usageError(fmt.Sprintf("unknown format %q", *format))
+	}
+
+	if err := sum(*format, *workers, *compensated, fs.Args(), stdin, stdout); err != nil {
+		fmt.Fprintln(stderr, "vecsum:", err)
+		return 1
+	}
+	return 0
+}
+
+func sum(format string, workers int, compensated bool, names []string, stdin io.Reader, stdout io.Writer) error {
+	var vectors []mypkg.Vector[float64]
+	read := func(r io.Reader) error {
+		v, err := readVectors(format, r)
+		vectors = append(vectors, v...)
+		return err
+	}
+	if len(names) == 0 {
+		if err := read(stdin); err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = read(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	if len(vectors) == 0 {
+		return errors.New("no vectors")
+	}
+
+	var result mypkg.Vector[float64]
+	var err error
+	if compensated {
+		result, err = mypkg.SumCompensated(vectors...)
+	} else {
+		result, err = mypkg.SumParallel(workers, vectors...)
+	}
+	if err != nil {
+		return err
+	}
+	if format == "ndjson" {
+		return mypkg.WriteNDJSON(stdout, result)
+	}
+	return mypkg.WriteVectorsCSV(stdout, []mypkg.Vector[float64]{result})
+}
+
+func readVectors(format string, r io.Reader) ([]mypkg.Vector[float64], error) {
+	if format == "csv" {
+		return mypkg.ReadVectorsCSV[float64](r)
+	}
+	var vectors []mypkg.Vector[float64]
+	for v, err := range mypkg.ReadNDJSON[float64](r) {
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
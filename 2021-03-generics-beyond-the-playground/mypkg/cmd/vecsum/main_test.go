@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/searis/subtest"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("With CSV on stdin", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(nil, strings.NewReader("1,0,3\n0,1,-2\n"), &stdout, &stderr)
+		t.Run("Expect exit code 0", subtest.Value(code).NumericEqual(0))
+		t.Run("Expect CSV sum", subtest.Value(stdout.String()).DeepEqual("1,1,1\n"))
+	})
+	t.Run("With ndjson files and compensated summation", func(t *testing.T) {
+		dir := t.TempDir()
+		a, b := filepath.Join(dir, "a.ndjson"), filepath.Join(dir, "b.ndjson")
+		if err := os.WriteFile(a, []byte("[1e100, 1]\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(b, []byte("[1, 1]\n[-1e100, 1]\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-format", "ndjson", "-compensated", a, b}, nil, &stdout, &stderr)
+		t.Run("Expect exit code 0", subtest.Value(code).NumericEqual(0))
+		t.Run("Expect exact sum", subtest.Value(stdout.String()).DeepEqual("[1,3]\n"))
+	})
+	t.Run("With parallel workers", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-workers", "0"}, strings.NewReader("1,2,3,4\n4,3,2,1\n"), &stdout, &stderr)
+		t.Run("Expect exit code 0", subtest.Value(code).NumericEqual(0))
+		t.Run("Expect CSV sum", subtest.Value(stdout.String()).DeepEqual("5,5,5,5\n"))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run(nil, strings.NewReader("1,2\n1\n"), &stdout, &stderr)
+		t.Run("Expect exit code 1", subtest.Value(code).NumericEqual(1))
+		t.Run("Expect dimension error", subtest.Value(stderr.String()).MatchPattern(`^vecsum: vector lengths unequal`))
+	})
+	for _, format := range []string{"csv", "ndjson"} {
+		t.Run("With empty "+format+" input", func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := run([]string{"-format", format}, strings.NewReader(""), &stdout, &stderr)
+			t.Run("Expect exit code 1", subtest.Value(code).NumericEqual(1))
+			t.Run("Expect no output", subtest.Value(stdout.String()).DeepEqual(""))
+			t.Run("Expect error", subtest.Value(stderr.String()).DeepEqual("vecsum: no vectors\n"))
+		})
+	}
+	t.Run("With -h", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-h"}, strings.NewReader(""), &stdout, &stderr)
+		t.Run("Expect exit code 0", subtest.Value(code).NumericEqual(0))
+		t.Run("Expect usage", subtest.Value(stderr.String()).MatchPattern(`^Usage of vecsum:`))
+	})
+	t.Run("With an undefined flag", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-x"}, strings.NewReader(""), &stdout, &stderr)
+		t.Run("Expect exit code 2", subtest.Value(code).NumericEqual(2))
+		t.Run("Expect the error printed once", subtest.Value(strings.Count(stderr.String(), "-x")).NumericEqual(1))
+	})
+	t.Run("With conflicting flags", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-compensated", "-workers", "4"}, strings.NewReader(""), &stdout, &stderr)
+		t.Run("Expect exit code 2", subtest.Value(code).NumericEqual(2))
+		t.Run("Expect error and usage", subtest.Value(stderr.String()).MatchPattern(`^-compensated cannot be combined with -workers\nUsage of vecsum:`))
+	})
+}
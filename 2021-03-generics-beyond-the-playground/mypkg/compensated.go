@@ -0,0 +1,41 @@
+package mypkg
+
+import "math"
+
+// SumCompensated returns the sum of multiple vectors of the same length, like
+// Sum, but uses Kahan-Babuška-Neumaier compensated summation per element. This
+// keeps the rounding error from growing with the number of vectors, at the
+// cost of a few extra floating-point operations per addition.
+func SumCompensated[T Float](vectors ...Vector[T]) (Vector[T], error) {
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+	if err := checkLengths(vectors...); err != nil {
+		return nil, err
+	}
+	l := len(vectors[0])
+	target := make(Vector[T], l)
+	comp := make(Vector[T], l)
+	for _, v := range vectors {
+		for i := 0; i < l; i++ {
+			sum, x := target[i], v[i]
+			t := sum + x
+			// Once the sum is infinite, the compensation term would be
+			// Inf - Inf = NaN. Skip it and let the infinity propagate.
+			if math.IsInf(float64(t), 0) {
+				target[i] = t
+				continue
+			}
+			if math.Abs(float64(sum)) >= math.Abs(float64(x)) {
+				comp[i] += (sum - t) + x
+			} else {
+				comp[i] += (x - t) + sum
+			}
+			target[i] = t
+		}
+	}
+	for i := range target {
+		target[i] += comp[i]
+	}
+	return target, nil
+}
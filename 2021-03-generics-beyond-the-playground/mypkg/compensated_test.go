@@ -0,0 +1,67 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSumCompensated(t *testing.T) {
+	t.Run("With many small values", func(t *testing.T) {
+		vectors := make([]mypkg.Vector[float64], 10000)
+		for i := range vectors {
+			vectors[i] = mypkg.Vector[float64]{0.1, 1}
+		}
+		var naive float64
+		for _, v := range vectors {
+			naive += v[0]
+		}
+		result, err := mypkg.SumCompensated(vectors...)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect naive summation to drift", subtest.Value(naive).NotNumericEqual(1000))
+		t.Run("Expect correctly rounded sum", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{1000, 10000}))
+	})
+	t.Run("With values cancelling out", func(t *testing.T) {
+		result, err := mypkg.SumCompensated(
+			mypkg.Vector[float64]{1},
+			mypkg.Vector[float64]{1e100},
+			mypkg.Vector[float64]{1},
+			mypkg.Vector[float64]{-1e100},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect small values to be kept", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{2}))
+	})
+	t.Run("With an overflowing sum", func(t *testing.T) {
+		result, err := mypkg.SumCompensated(
+			mypkg.Vector[float64]{math.MaxFloat64, -math.MaxFloat64},
+			mypkg.Vector[float64]{math.MaxFloat64, -math.MaxFloat64},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect signed infinities", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{math.Inf(1), math.Inf(-1)}))
+	})
+	t.Run("With an infinite input", func(t *testing.T) {
+		result, err := mypkg.SumCompensated(
+			mypkg.Vector[float32]{float32(math.Inf(1)), 1},
+			mypkg.Vector[float32]{1, float32(math.Inf(-1))},
+			mypkg.Vector[float32]{0.1, 0.1},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect infinities to propagate", subtest.Value(result).DeepEqual(mypkg.Vector[float32]{float32(math.Inf(1)), float32(math.Inf(-1))}))
+	})
+	t.Run("With opposite infinities", func(t *testing.T) {
+		result, err := mypkg.SumCompensated(mypkg.Vector[float64]{math.Inf(1)}, mypkg.Vector[float64]{math.Inf(-1)})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect NaN", subtest.Value(math.IsNaN(result[0])).DeepEqual(true))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.SumCompensated(mypkg.Vector[float32]{1, 2}, mypkg.Vector[float32]{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With no vectors", func(t *testing.T) {
+		result, err := mypkg.SumCompensated[float64]()
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect nil", subtest.Value(result).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+}
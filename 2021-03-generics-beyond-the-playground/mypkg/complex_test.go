@@ -0,0 +1,65 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSum_complex(t *testing.T) {
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Sum(mypkg.Vector[complex128]{1 + 2i, 3}, mypkg.Vector[complex128]{1i})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("When calling SumInto", func(t *testing.T) {
+		dst := make(mypkg.Vector[complex128], 2)
+		err := mypkg.SumInto(dst, mypkg.Vector[complex128]{1 + 2i, 3}, mypkg.Vector[complex128]{1i, -1 - 1i})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(dst).DeepEqual(mypkg.Vector[complex128]{1 + 3i, 2 - 1i}))
+	})
+}
+
+func TestDot_complex(t *testing.T) {
+	t.Run("With complex128 vectors", func(t *testing.T) {
+		a := mypkg.Vector[complex128]{1 + 2i, 3}
+		b := mypkg.Vector[complex128]{4, 1i}
+		result, err := mypkg.Dot(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect a to be conjugated", subtest.Value(result).DeepEqual(complex128(4-5i)))
+	})
+	t.Run("With a complex64 vector dotted with itself", func(t *testing.T) {
+		v := mypkg.Vector[complex64]{3 + 4i, 1i}
+		result, err := mypkg.Dot(v, v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect the squared norm", subtest.Value(result).DeepEqual(complex64(26)))
+	})
+	t.Run("With named complex element type", func(t *testing.T) {
+		type phasor complex128
+		a := mypkg.Vector[phasor]{1 + 2i, 3}
+		b := mypkg.Vector[phasor]{4, 1i}
+		result, err := mypkg.Dot(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect a to be conjugated", subtest.Value(result).DeepEqual(phasor(4-5i)))
+	})
+}
+
+func TestVectorNorm_complex(t *testing.T) {
+	t.Run("With complex128 vector", func(t *testing.T) {
+		v := mypkg.Vector[complex128]{3 + 4i, 0}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(5))
+	})
+	t.Run("With complex64 vector", func(t *testing.T) {
+		v := mypkg.Vector[complex64]{1i, 1i, 1i, 1i}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(2))
+	})
+	t.Run("With int vector", func(t *testing.T) {
+		v := mypkg.Vector[int]{-3, 4}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(5))
+	})
+	t.Run("With uint8 vector", func(t *testing.T) {
+		v := mypkg.Vector[uint8]{1, 1}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(math.Sqrt2))
+	})
+}
@@ -0,0 +1,57 @@
+package mypkg
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ReadVectorsCSV reads all rows from r as CSV, returning one vector per row.
+// Rows may have different lengths; functions such as Sum report any mismatch
+// as a DimensionError. Fields are trimmed of surrounding whitespace. Parse errors
+// report the 1-based input line and column where the offending field starts,
+// like csv.ParseError does.
+func ReadVectorsCSV[T Real](r io.Reader) ([]Vector[T], error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	var vectors []Vector[T]
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return vectors, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		v := make(Vector[T], len(record))
+		for i, field := range record {
+			if err := parseElement(strings.TrimSpace(field), &v[i]); err != nil {
+				line, col := cr.FieldPos(i)
+				return nil, fmt.Errorf("line %d, column %d: %w", line, col, err)
+			}
+		}
+		vectors = append(vectors, v)
+	}
+}
+
+// WriteVectorsCSV writes each vector as a CSV row to w. Elements are formatted
+// as by fmt, so floating point values round-trip exactly through
+// ReadVectorsCSV.
+func WriteVectorsCSV[T Real](w io.Writer, vectors []Vector[T]) error {
+	cw := csv.NewWriter(w)
+	var record []string
+	for _, v := range vectors {
+		record = record[:0]
+		for _, x := range v {
+			record = append(record, fmt.Sprint(x))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
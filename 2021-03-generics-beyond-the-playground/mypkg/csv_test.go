@@ -0,0 +1,56 @@
+package mypkg_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestReadVectorsCSV(t *testing.T) {
+	t.Run("With valid input", func(t *testing.T) {
+		r := strings.NewReader("1, 0, 3\n0,1,-2.5\n4\n")
+		vectors, err := mypkg.ReadVectorsCSV[float64](r)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect one vector per row", subtest.Value(vectors).DeepEqual([]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2.5}, {4}}))
+	})
+	t.Run("With an invalid field", func(t *testing.T) {
+		r := strings.NewReader("1,0,3\n0,x,-2\n")
+		_, err := mypkg.ReadVectorsCSV[float64](r)
+		t.Run("Expect error with line and column", subtest.Value(err).MatchPattern(`^line 2, column 3: `))
+	})
+	t.Run("With an invalid field after blank lines", func(t *testing.T) {
+		r := strings.NewReader("1,2\n\n\n3, 4x\n")
+		_, err := mypkg.ReadVectorsCSV[float64](r)
+		t.Run("Expect error with input line", subtest.Value(err).MatchPattern(`^line 4, column 4: `))
+	})
+	t.Run("With an invalid field after a multi-line quoted field", func(t *testing.T) {
+		r := strings.NewReader("\"1\n\",2\nx\n")
+		_, err := mypkg.ReadVectorsCSV[float64](r)
+		t.Run("Expect error with input line", subtest.Value(err).MatchPattern(`^line 3, column 1: `))
+	})
+	t.Run("With malformed CSV", func(t *testing.T) {
+		r := strings.NewReader("1,\"2\n")
+		_, err := mypkg.ReadVectorsCSV[float64](r)
+		t.Run("Expect csv quote error", subtest.Value(err).ErrorIs(csv.ErrQuote))
+	})
+	t.Run("With empty input", func(t *testing.T) {
+		vectors, err := mypkg.ReadVectorsCSV[int](strings.NewReader(""))
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect no vectors", subtest.Value(len(vectors)).NumericEqual(0))
+	})
+}
+
+func TestWriteVectorsCSV(t *testing.T) {
+	vectors := []mypkg.Vector[float64]{{1, 0.1, -3}, {2e21}}
+	var buf bytes.Buffer
+	err := mypkg.WriteVectorsCSV(&buf, vectors)
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect CSV output", subtest.Value(buf.String()).DeepEqual("1,0.1,-3\n2e+21\n"))
+	result, err := mypkg.ReadVectorsCSV[float64](&buf)
+	t.Run("Expect round-trip without error", subtest.Value(err).NoError())
+	t.Run("Expect round-trip to equal input", subtest.Value(result).DeepEqual(vectors))
+}
@@ -0,0 +1,138 @@
+package mypkg
+
+import (
+	"math"
+	"math/cmplx"
+	"reflect"
+)
+
+// Dot returns the dot product of a and b; an error is returned if the vectors
+// have different lengths. For complex vectors, the elements of a are
+// conjugated, so that Dot(v, v) is real and equal to the squared norm of v.
+func Dot[T Number](a, b Vector[T]) (T, error) {
+	if err := checkLengths(a, b); err != nil {
+		return 0, err
+	}
+	if isComplex[T]() {
+		return dotConj(a, b), nil
+	}
+	var sum T
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// Norm returns the Euclidean norm (length) of v.
+func (v Vector[T]) Norm() float64 {
+	switch s := any([]T(v)).(type) {
+	case []float64:
+		return normReal(s)
+	case []float32:
+		return normReal(s)
+	case []int:
+		return normReal(s)
+	case []int64:
+		return normReal(s)
+	case []int32:
+		return normReal(s)
+	case []int16:
+		return normReal(s)
+	case []int8:
+		return normReal(s)
+	case []uint:
+		return normReal(s)
+	case []uint64:
+		return normReal(s)
+	case []uint32:
+		return normReal(s)
+	case []uint16:
+		return normReal(s)
+	case []uint8:
+		return normReal(s)
+	case []uintptr:
+		return normReal(s)
+	case []complex128:
+		return normComplex(s)
+	case []complex64:
+		return normComplex(s)
+	}
+	// Named element types, e.g. `type Celsius float64`, fall back to reflect.
+	rv := reflect.ValueOf(v)
+	var sum float64
+	for i := range v {
+		a := abs(rv.Index(i))
+		sum += a * a
+	}
+	return math.Sqrt(sum)
+}
+
+func normReal[T Real](s []T) float64 {
+	var sum float64
+	for _, x := range s {
+		f := float64(x)
+		sum += f * f
+	}
+	return math.Sqrt(sum)
+}
+
+func normComplex[T Complex](s []T) float64 {
+	var sum float64
+	for _, x := range s {
+		z := complex128(x)
+		sum += real(z)*real(z) + imag(z)*imag(z)
+	}
+	return math.Sqrt(sum)
+}
+
+// isComplex reports whether T is a complex type. Because Number mixes real and
+// complex types, generic code can't call real, imag or float64 on a T
+// directly; complex-specific paths go through reflect instead.
+func isComplex[T Number]() bool {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		return true
+	}
+	return false
+}
+
+// dotConj returns the sum of conj(a[i]) * b[i] for complex vectors of equal
+// length. Named complex types fall back to reflect.
+func dotConj[T Number](a, b Vector[T]) T {
+	switch s := any([]T(a)).(type) {
+	case []complex128:
+		return any(dotConjComplex(s, any([]T(b)).([]complex128))).(T)
+	case []complex64:
+		return any(dotConjComplex(s, any([]T(b)).([]complex64))).(T)
+	}
+	ra, rb := reflect.ValueOf(a), reflect.ValueOf(b)
+	var sum complex128
+	for i := range a {
+		sum += cmplx.Conj(ra.Index(i).Complex()) * rb.Index(i).Complex()
+	}
+	var result T
+	reflect.ValueOf(&result).Elem().SetComplex(sum)
+	return result
+}
+
+func dotConjComplex[T Complex](a, b []T) T {
+	var sum complex128
+	for i := range a {
+		sum += cmplx.Conj(complex128(a[i])) * complex128(b[i])
+	}
+	return T(sum)
+}
+
+// abs returns the absolute value of a numeric reflect value.
+func abs(x reflect.Value) float64 {
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return math.Abs(float64(x.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(x.Uint())
+	case reflect.Float32, reflect.Float64:
+		return math.Abs(x.Float())
+	default:
+		return cmplx.Abs(x.Complex())
+	}
+}
@@ -0,0 +1,91 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestDot(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		a := mypkg.Vector[float64]{1, 0, 3}
+		b := mypkg.Vector[float64]{0, 1, -2}
+		result, err := mypkg.Dot(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct dot product", subtest.Value(result).NumericEqual(-6))
+		t.Run("Expect inputs are unchanged", subtest.Value([]mypkg.Vector[float64]{a, b}).DeepEqual(
+			[]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2}},
+		))
+	})
+	t.Run("With int vectors", func(t *testing.T) {
+		result, err := mypkg.Dot(mypkg.Vector[int]{1, 2, 3}, mypkg.Vector[int]{4, 5, 6})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct dot product", subtest.Value(result).NumericEqual(32))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Dot(mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{0, 1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestVectorNorm(t *testing.T) {
+	t.Run("With non-empty vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{3, 0, -4}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(5))
+	})
+	t.Run("With empty vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{}
+		t.Run("Expect zero", subtest.Value(v.Norm()).NumericEqual(0))
+	})
+	t.Run("With int8 vector", func(t *testing.T) {
+		v := mypkg.Vector[int8]{-3, 4}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(5))
+	})
+	t.Run("With named element type", func(t *testing.T) {
+		type celsius float64
+		v := mypkg.Vector[celsius]{3, -4}
+		t.Run("Expect correct norm", subtest.Value(v.Norm()).NumericEqual(5))
+	})
+}
+
+func BenchmarkDot(b *testing.B) {
+	x := make(mypkg.Vector[float64], 1000)
+	y := make(mypkg.Vector[float64], 1000)
+	for i := range x {
+		x[i] = float64(i)
+		y[i] = float64(len(y) - i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.Dot(x, y); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDot_complex(b *testing.B) {
+	x := make(mypkg.Vector[complex128], 1000)
+	y := make(mypkg.Vector[complex128], 1000)
+	for i := range x {
+		x[i] = complex(float64(i), 1)
+		y[i] = complex(float64(len(y)-i), -1)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.Dot(x, y); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNorm(b *testing.B) {
+	x := make(mypkg.Vector[float64], 1000)
+	for i := range x {
+		x[i] = float64(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.Norm()
+	}
+}
@@ -0,0 +1,87 @@
+package mypkg
+
+import "fmt"
+
+// DimensionError is returned when a vector has a different length then
+// expected. It can be matched with errors.As to find out which vector was
+// malformed.
+type DimensionError struct {
+	// Index is the position of the malformed vector in the argument list.
+	Index int
+	// Want is the expected length.
+	Want int
+	// Got is the actual length.
+	Got int
+}
+
+func (err DimensionError) Error() string {
+	return fmt.Sprintf("vector lengths unequal: vector %d has length %d, want %d", err.Index, err.Got, err.Want)
+}
+
+// DivisionByZeroError is returned by Div when one or more elements of the
+// divisor are zero.
+type DivisionByZeroError struct {
+	// Indices holds the index of each zero element in the divisor.
+	Indices []int
+}
+
+func (err DivisionByZeroError) Error() string {
+	return fmt.Sprintf("division by zero at indices %v", err.Indices)
+}
+
+// Sub returns the element-wise difference a - b; an error is returned if the
+// vectors have different lengths.
+func Sub[T Number](a, b Vector[T]) (Vector[T], error) {
+	return elementwise(a, b, func(x, y T) T { return x - y })
+}
+
+// Mul returns the element-wise (Hadamard) product of a and b; an error is
+// returned if the vectors have different lengths.
+func Mul[T Number](a, b Vector[T]) (Vector[T], error) {
+	return elementwise(a, b, func(x, y T) T { return x * y })
+}
+
+// Div returns the element-wise quotient a / b; an error is returned if the
+// vectors have different lengths. If any element of b is zero, a
+// DivisionByZeroError listing all zero indices is returned.
+func Div[T Number](a, b Vector[T]) (Vector[T], error) {
+	if err := checkLengths(a, b); err != nil {
+		return nil, err
+	}
+	var zeros []int
+	for i, y := range b {
+		if y == 0 {
+			zeros = append(zeros, i)
+		}
+	}
+	if len(zeros) > 0 {
+		return nil, DivisionByZeroError{Indices: zeros}
+	}
+	return elementwise(a, b, func(x, y T) T { return x / y })
+}
+
+func elementwise[T Number](a, b Vector[T], op func(x, y T) T) (Vector[T], error) {
+	if err := checkLengths(a, b); err != nil {
+		return nil, err
+	}
+	target := make(Vector[T], len(a))
+	for i := range a {
+		target[i] = op(a[i], b[i])
+	}
+	return target, nil
+}
+
+// checkLengths returns a DimensionError for the first vector that doesn't have
+// the same length as vectors[0].
+func checkLengths[T Number](vectors ...Vector[T]) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	want := len(vectors[0])
+	for i, v := range vectors[1:] {
+		if len(v) != want {
+			return DimensionError{Index: i + 1, Want: want, Got: len(v)}
+		}
+	}
+	return nil
+}
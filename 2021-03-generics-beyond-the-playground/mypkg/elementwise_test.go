@@ -0,0 +1,76 @@
+package mypkg_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSub(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		result, err := mypkg.Sub(mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{0, 1, -2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct difference", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{1, -1, 5}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Sub(mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{0, 1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestMul(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		result, err := mypkg.Mul(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{0, 1, -2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct product", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{0, 2, -6}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Mul(mypkg.Vector[float64]{1}, mypkg.Vector[float64]{0, 1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestDiv(t *testing.T) {
+	t.Run("With non-zero divisor", func(t *testing.T) {
+		result, err := mypkg.Div(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{2, 1, -3})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct quotient", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{0.5, 2, -1}))
+	})
+	t.Run("With zero elements in int divisor", func(t *testing.T) {
+		_, err := mypkg.Div(mypkg.Vector[int]{1, 2, 3}, mypkg.Vector[int]{0, 1, 0})
+		var zeroErr mypkg.DivisionByZeroError
+		t.Run("Expect a DivisionByZeroError", subtest.Value(errors.As(err, &zeroErr)).DeepEqual(true))
+		t.Run("Expect zero indices", subtest.Value(zeroErr.Indices).DeepEqual([]int{0, 2}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Div(mypkg.Vector[float64]{1, 2}, mypkg.Vector[float64]{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestDimensionError(t *testing.T) {
+	t.Run("When calling Sub with a short second vector", func(t *testing.T) {
+		_, err := mypkg.Sub(mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{0, 1})
+		var dimErr mypkg.DimensionError
+		t.Run("Expect a DimensionError", subtest.Value(errors.As(err, &dimErr)).DeepEqual(true))
+		t.Run("Expect correct details", subtest.Value(dimErr).DeepEqual(mypkg.DimensionError{Index: 1, Want: 3, Got: 2}))
+		t.Run("Expect descriptive message", subtest.Value(err).MatchPattern(
+			`^vector lengths unequal: vector 1 has length 2, want 3$`,
+		))
+	})
+	t.Run("When calling SumInto with a malformed vector", func(t *testing.T) {
+		dst := make(mypkg.Vector[float64], 3)
+		err := mypkg.SumInto(dst, mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{1})
+		t.Run("Expect index to match the argument position", subtest.Value(err).DeepEqual(
+			mypkg.DimensionError{Index: 2, Want: 3, Got: 1},
+		))
+	})
+	t.Run("When calling Where with a malformed b", func(t *testing.T) {
+		_, err := mypkg.Where(mypkg.BoolVector{true, false}, mypkg.Vector[float64]{1, 2}, mypkg.Vector[float64]{1})
+		t.Run("Expect index to match the argument position", subtest.Value(err).DeepEqual(
+			mypkg.DimensionError{Index: 2, Want: 2, Got: 1},
+		))
+	})
+}
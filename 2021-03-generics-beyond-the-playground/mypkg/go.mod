@@ -1,8 +1,15 @@
 module github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg
 
-go 1.14
+go 1.23
 
 require (
 	github.com/searis/subtest v0.1.0
 	github.com/stretchr/testify v1.7.0
+	gonum.org/v1/gonum v0.15.1
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
 )
@@ -0,0 +1,33 @@
+// Package gonumvec converts between mypkg vectors and gonum's mat.VecDense.
+// It lives in its own package so that importing mypkg alone doesn't pull in
+// gonum.
+package gonumvec
+
+import (
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+	"gonum.org/v1/gonum/mat"
+)
+
+// ToVecDense returns a *mat.VecDense holding a copy of v. An empty v gives an
+// empty VecDense, which gonum accepts as a receiver.
+func ToVecDense(v mypkg.Vector[float64]) *mat.VecDense {
+	if len(v) == 0 {
+		return &mat.VecDense{}
+	}
+	data := make([]float64, len(v))
+	copy(data, v)
+	return mat.NewVecDense(len(data), data)
+}
+
+// FromVecDense returns a vector holding a copy of the elements in d. A nil or
+// empty d gives an empty vector.
+func FromVecDense(d *mat.VecDense) mypkg.Vector[float64] {
+	if d == nil || d.IsEmpty() {
+		return mypkg.Vector[float64]{}
+	}
+	target := make(mypkg.Vector[float64], d.Len())
+	for i := range target {
+		target[i] = d.AtVec(i)
+	}
+	return target
+}
@@ -0,0 +1,41 @@
+package gonumvec_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg/gonumvec"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestToVecDense(t *testing.T) {
+	t.Run("With non-empty vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, 3}
+		d := gonumvec.ToVecDense(v)
+		d.ScaleVec(2, d)
+		t.Run("Expect gonum to see the elements", subtest.Value(d.RawVector().Data).DeepEqual([]float64{2, 0, 6}))
+		t.Run("Expect input is unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 0, 3}))
+	})
+	t.Run("With empty vector", func(t *testing.T) {
+		d := gonumvec.ToVecDense(mypkg.Vector[float64]{})
+		t.Run("Expect empty VecDense", subtest.Value(d.IsEmpty()).DeepEqual(true))
+	})
+}
+
+func TestFromVecDense(t *testing.T) {
+	t.Run("With strided VecDense", func(t *testing.T) {
+		m := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+		col := m.ColView(1).(*mat.VecDense)
+		v := gonumvec.FromVecDense(col)
+		t.Run("Expect column elements", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{2, 4}))
+	})
+	t.Run("With nil VecDense", func(t *testing.T) {
+		v := gonumvec.FromVecDense(nil)
+		t.Run("Expect empty vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{}))
+	})
+	t.Run("When round-tripping", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, -2.5, 3}
+		t.Run("Expect equal vector", subtest.Value(gonumvec.FromVecDense(gonumvec.ToVecDense(v))).DeepEqual(v))
+	})
+}
@@ -0,0 +1,35 @@
+package mypkg
+
+import "fmt"
+
+// Lerp returns the linear interpolation between a and b at t, i.e. a when t
+// is 0 and b when t is 1. Values of t outside [0, 1] extrapolate. An error is
+// returned if a and b have different lengths.
+func Lerp[T Float](a, b Vector[T], t float64) (Vector[T], error) {
+	if err := checkLengths(a, b); err != nil {
+		return nil, err
+	}
+	target := make(Vector[T], len(a))
+	for i := range a {
+		// This form is exact at both t == 0 and t == 1.
+		target[i] = T(float64(a[i])*(1-t) + float64(b[i])*t)
+	}
+	return target, nil
+}
+
+// Clamp returns a new vector with each element of v limited to the range
+// [lo[i], hi[i]]. An error is returned if the vectors have different lengths,
+// or if lo[i] > hi[i] for any element. NaN elements of v are left as NaN.
+func Clamp[T Real](v, lo, hi Vector[T]) (Vector[T], error) {
+	if err := checkLengths(v, lo, hi); err != nil {
+		return nil, err
+	}
+	target := make(Vector[T], len(v))
+	for i, x := range v {
+		if lo[i] > hi[i] {
+			return nil, fmt.Errorf("clamp bounds inverted at element %d: %v > %v", i, lo[i], hi[i])
+		}
+		target[i] = max(lo[i], min(x, hi[i]))
+	}
+	return target, nil
+}
@@ -0,0 +1,52 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestLerp(t *testing.T) {
+	a := mypkg.Vector[float64]{0, 10, 0.1}
+	b := mypkg.Vector[float64]{1, -10, 0.7}
+	t.Run("With t=0", func(t *testing.T) {
+		v, err := mypkg.Lerp(a, b, 0)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect a", subtest.Value(v).DeepEqual(a))
+	})
+	t.Run("With t=1", func(t *testing.T) {
+		v, err := mypkg.Lerp(a, b, 1)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect exactly b", subtest.Value(v).DeepEqual(b))
+	})
+	t.Run("With t=0.25", func(t *testing.T) {
+		v, err := mypkg.Lerp(mypkg.Vector[float32]{0, 4}, mypkg.Vector[float32]{8, 0}, 0.25)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect interpolated vector", subtest.Value(v).DeepEqual(mypkg.Vector[float32]{2, 3}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Lerp(a, b[:2], 0.5)
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 1, Want: 3, Got: 2}))
+	})
+}
+
+func TestClamp(t *testing.T) {
+	lo := mypkg.Vector[float64]{0, -1, 2, 0}
+	hi := mypkg.Vector[float64]{1, 1, 2, 1}
+	t.Run("With per-element bounds", func(t *testing.T) {
+		v, err := mypkg.Clamp(mypkg.Vector[float64]{-3, 0.5, 7, math.NaN()}, lo, hi)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect clamped elements", subtest.Value(v[:3]).DeepEqual(mypkg.Vector[float64]{0, 0.5, 2}))
+		t.Run("Expect NaN to be kept", subtest.Value(math.IsNaN(v[3])).DeepEqual(true))
+	})
+	t.Run("With inverted bounds", func(t *testing.T) {
+		_, err := mypkg.Clamp(mypkg.Vector[int]{1, 2}, mypkg.Vector[int]{0, 5}, mypkg.Vector[int]{3, 4})
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^clamp bounds inverted at element 1: 5 > 4$`))
+	})
+	t.Run("With bounds of unequal length", func(t *testing.T) {
+		_, err := mypkg.Clamp(mypkg.Vector[float64]{1, 2, 3, 4}, lo, hi[:3])
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 2, Want: 4, Got: 3}))
+	})
+}
@@ -0,0 +1,55 @@
+package mypkg
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes v as a JSON array of numbers, or null for a nil vector.
+// Elements are encoded one by one, as encoding/json would otherwise encode
+// vectors of uint8 elements as base64 strings.
+func (v Vector[T]) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+	b := []byte{'['}
+	for i, x := range v {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		e, err := json.Marshal(x)
+		if err != nil {
+			return nil, fmt.Errorf("vector element %d: %w", i, err)
+		}
+		b = append(b, e...)
+	}
+	return append(b, ']'), nil
+}
+
+// UnmarshalJSON decodes a JSON array of numbers into v. Elements that are not
+// JSON numbers, or that don't fit the element type, are rejected with an error
+// reporting their index. A JSON null leaves v unchanged.
+func (v *Vector[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	target := make(Vector[T], len(raw))
+	for i, r := range raw {
+		if !isJSONNumber(r) {
+			return fmt.Errorf("vector element %d: not a number: %s", i, r)
+		}
+		if err := json.Unmarshal(r, &target[i]); err != nil {
+			return fmt.Errorf("vector element %d: %w", i, err)
+		}
+	}
+	*v = target
+	return nil
+}
+
+func isJSONNumber(b []byte) bool {
+	return len(b) > 0 && (b[0] == '-' || (b[0] >= '0' && b[0] <= '9'))
+}
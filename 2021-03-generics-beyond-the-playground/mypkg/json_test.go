@@ -0,0 +1,83 @@
+package mypkg_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestVectorMarshalJSON(t *testing.T) {
+	t.Run("With float64 vector", func(t *testing.T) {
+		b, err := json.Marshal(mypkg.Vector[float64]{1, 2.5, -3})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect JSON array", subtest.Value(string(b)).DeepEqual("[1,2.5,-3]"))
+	})
+	t.Run("With uint8 vector", func(t *testing.T) {
+		v := mypkg.Vector[uint8]{1, 2, 255}
+		b, err := json.Marshal(v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect JSON array rather than base64", subtest.Value(string(b)).DeepEqual("[1,2,255]"))
+		var decoded mypkg.Vector[uint8]
+		err = json.Unmarshal(b, &decoded)
+		t.Run("Expect round-trip without error", subtest.Value(err).NoError())
+		t.Run("Expect round-trip to equal vector", subtest.Value(decoded).DeepEqual(v))
+	})
+	t.Run("With named byte element type", func(t *testing.T) {
+		type level uint8
+		b, err := json.Marshal(mypkg.Vector[level]{3})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect JSON array", subtest.Value(string(b)).DeepEqual("[3]"))
+	})
+	t.Run("With empty and nil vectors", func(t *testing.T) {
+		empty, err1 := json.Marshal(mypkg.Vector[float64]{})
+		null, err2 := json.Marshal(mypkg.Vector[float64](nil))
+		t.Run("Expect no errors", subtest.Value([]error{err1, err2}).DeepEqual([]error{nil, nil}))
+		t.Run("Expect empty array", subtest.Value(string(empty)).DeepEqual("[]"))
+		t.Run("Expect null", subtest.Value(string(null)).DeepEqual("null"))
+	})
+}
+
+func TestVectorUnmarshalJSON(t *testing.T) {
+	t.Run("With numeric array", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := json.Unmarshal([]byte(`[1, 2.5, -3]`), &v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect decoded vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 2.5, -3}))
+	})
+	t.Run("With a string element", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := json.Unmarshal([]byte(`[1, "2", 3]`), &v)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: not a number: "2"$`))
+		t.Run("Expect vector is unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+	t.Run("With a null element", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := json.Unmarshal([]byte(`[null]`), &v)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 0: not a number: null$`))
+	})
+	t.Run("With a fraction for an int vector", func(t *testing.T) {
+		var v mypkg.Vector[int]
+		err := json.Unmarshal([]byte(`[1, 2, 2.5]`), &v)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 2: `))
+	})
+	t.Run("With a non-array value", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := json.Unmarshal([]byte(`{"a": 1}`), &v)
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With null", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1}
+		err := json.Unmarshal([]byte(`null`), &v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect vector is unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1}))
+	})
+	t.Run("When used as a struct field", func(t *testing.T) {
+		var s struct {
+			V mypkg.Vector[float64] `json:"v"`
+		}
+		err := json.Unmarshal([]byte(`{"v": [1, true]}`), &s)
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`vector element 1: not a number: true`))
+	})
+}
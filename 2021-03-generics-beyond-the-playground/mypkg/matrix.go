@@ -0,0 +1,164 @@
+package mypkg
+
+import (
+	"fmt"
+	"iter"
+)
+
+// ShapeError is returned when a matrix has a different shape than expected.
+// It can be matched with errors.As to find out which matrix was malformed.
+type ShapeError struct {
+	// Index is the position of the malformed matrix in the argument list.
+	Index int
+	// WantRows and WantCols are the expected shape.
+	WantRows, WantCols int
+	// GotRows and GotCols are the actual shape.
+	GotRows, GotCols int
+}
+
+func (err ShapeError) Error() string {
+	return fmt.Sprintf("matrix shape mismatch: matrix %d has shape %dx%d, want %dx%d", err.Index, err.GotRows, err.GotCols, err.WantRows, err.WantCols)
+}
+
+// Index2 is the row and column index of a matrix element.
+type Index2 struct {
+	Row, Col int
+}
+
+// Matrix is a dense matrix of numeric elements of type T, stored in row-major
+// order. The zero value is an empty 0x0 matrix.
+type Matrix[T Number] struct {
+	rows, cols int
+	data       []T
+}
+
+// NewMatrix returns a zero-filled matrix with the given number of rows and
+// columns; an error is returned if either is negative.
+func NewMatrix[T Number](rows, cols int) (Matrix[T], error) {
+	if rows < 0 || cols < 0 {
+		return Matrix[T]{}, fmt.Errorf("negative matrix shape %dx%d", rows, cols)
+	}
+	return newMatrix[T](rows, cols), nil
+}
+
+func newMatrix[T Number](rows, cols int) Matrix[T] {
+	return Matrix[T]{rows: rows, cols: cols, data: make([]T, rows*cols)}
+}
+
+// MatrixFromRows returns a matrix holding a copy of the passed in rows; an
+// error is returned if the rows have different lengths.
+func MatrixFromRows[T Number](rows ...Vector[T]) (Matrix[T], error) {
+	if err := checkLengths(rows...); err != nil {
+		return Matrix[T]{}, err
+	}
+	if len(rows) == 0 {
+		return Matrix[T]{}, nil
+	}
+	m := newMatrix[T](len(rows), len(rows[0]))
+	for i, r := range rows {
+		copy(m.data[i*m.cols:], r)
+	}
+	return m, nil
+}
+
+// Rows returns the number of rows in m.
+func (m Matrix[T]) Rows() int {
+	return m.rows
+}
+
+// Cols returns the number of columns in m.
+func (m Matrix[T]) Cols() int {
+	return m.cols
+}
+
+// At returns the element at row i, column j. It panics if the index is out of
+// range.
+func (m Matrix[T]) At(i, j int) T {
+	return m.data[m.index(i, j)]
+}
+
+// Set sets the element at row i, column j to v. It panics if the index is out
+// of range.
+func (m Matrix[T]) Set(i, j int, v T) {
+	m.data[m.index(i, j)] = v
+}
+
+// Row returns a copy of row i as a vector. It panics if i is out of range.
+func (m Matrix[T]) Row(i int) Vector[T] {
+	if i < 0 || i >= m.rows {
+		panic(fmt.Sprintf("row index %d out of range [0:%d]", i, m.rows))
+	}
+	target := make(Vector[T], m.cols)
+	copy(target, m.data[i*m.cols:(i+1)*m.cols])
+	return target
+}
+
+func (m Matrix[T]) index(i, j int) int {
+	if i < 0 || i >= m.rows || j < 0 || j >= m.cols {
+		panic(fmt.Sprintf("index (%d, %d) out of range for %dx%d matrix", i, j, m.rows, m.cols))
+	}
+	return i*m.cols + j
+}
+
+// Cells returns an iterator over the index and value of each element in m, in
+// row-major order.
+func (m Matrix[T]) Cells() iter.Seq2[Index2, T] {
+	return func(yield func(Index2, T) bool) {
+		for k, x := range m.data {
+			if !yield(Index2{Row: k / m.cols, Col: k % m.cols}, x) {
+				return
+			}
+		}
+	}
+}
+
+// SumMatrix returns the sum of multiple matrices of the same shape; an error
+// is returned if one of the matrices has a different shape then the others.
+func SumMatrix[T Number](matrices ...Matrix[T]) (Matrix[T], error) {
+	if len(matrices) == 0 {
+		return Matrix[T]{}, nil
+	}
+	rows, cols := matrices[0].rows, matrices[0].cols
+	for i, m := range matrices[1:] {
+		if m.rows != rows || m.cols != cols {
+			return Matrix[T]{}, ShapeError{Index: i + 1, WantRows: rows, WantCols: cols, GotRows: m.rows, GotCols: m.cols}
+		}
+	}
+	target := newMatrix[T](rows, cols)
+	for _, m := range matrices {
+		for i, v := range m.data {
+			target.data[i] += v
+		}
+	}
+	return target, nil
+}
+
+// Transpose returns a new matrix that is the transpose of m.
+func (m Matrix[T]) Transpose() Matrix[T] {
+	target := newMatrix[T](m.cols, m.rows)
+	for i := 0; i < m.rows; i++ {
+		for j := 0; j < m.cols; j++ {
+			target.data[j*target.cols+i] = m.data[i*m.cols+j]
+		}
+	}
+	return target
+}
+
+// Mul returns the matrix product m × o; a ShapeError is returned if the number
+// of columns in m doesn't match the number of rows in o. The error has Index 1,
+// counting m as matrix 0, and differs from the shape of o only in its rows.
+func (m Matrix[T]) Mul(o Matrix[T]) (Matrix[T], error) {
+	if m.cols != o.rows {
+		return Matrix[T]{}, ShapeError{Index: 1, WantRows: m.cols, WantCols: o.cols, GotRows: o.rows, GotCols: o.cols}
+	}
+	target := newMatrix[T](m.rows, o.cols)
+	for i := 0; i < m.rows; i++ {
+		for k := 0; k < m.cols; k++ {
+			a := m.data[i*m.cols+k]
+			for j := 0; j < o.cols; j++ {
+				target.data[i*target.cols+j] += a * o.data[k*o.cols+j]
+			}
+		}
+	}
+	return target, nil
+}
@@ -0,0 +1,140 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func mustMatrix(t *testing.T, rows ...mypkg.Vector[float64]) mypkg.Matrix[float64] {
+	t.Helper()
+	m, err := mypkg.MatrixFromRows(rows...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func mustNewMatrix(t *testing.T, rows, cols int) mypkg.Matrix[float64] {
+	t.Helper()
+	m, err := mypkg.NewMatrix[float64](rows, cols)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestNewMatrix(t *testing.T) {
+	t.Run("With a valid shape", func(t *testing.T) {
+		m, err := mypkg.NewMatrix[float64](2, 3)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct number of rows", subtest.Value(m.Rows()).NumericEqual(2))
+		t.Run("Expect correct number of columns", subtest.Value(m.Cols()).NumericEqual(3))
+		t.Run("Expect zero-filled elements", subtest.Value(m.At(1, 2)).NumericEqual(0))
+	})
+	t.Run("With negative rows", func(t *testing.T) {
+		_, err := mypkg.NewMatrix[float64](-1, 3)
+		t.Run("Expect descriptive error", subtest.Value(err).MatchPattern(`^negative matrix shape -1x3$`))
+	})
+	t.Run("With negative columns", func(t *testing.T) {
+		_, err := mypkg.NewMatrix[float64](2, -3)
+		t.Run("Expect descriptive error", subtest.Value(err).MatchPattern(`^negative matrix shape 2x-3$`))
+	})
+}
+
+func TestMatrixFromRows(t *testing.T) {
+	t.Run("With rows of equal length", func(t *testing.T) {
+		m, err := mypkg.MatrixFromRows(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5, 6})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct number of rows", subtest.Value(m.Rows()).NumericEqual(2))
+		t.Run("Expect correct number of columns", subtest.Value(m.Cols()).NumericEqual(3))
+		t.Run("Expect correct element", subtest.Value(m.At(1, 0)).NumericEqual(4))
+		t.Run("Expect correct row", subtest.Value(m.Row(1)).DeepEqual(mypkg.Vector[float64]{4, 5, 6}))
+	})
+	t.Run("With rows of unequal length", func(t *testing.T) {
+		_, err := mypkg.MatrixFromRows(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestSumMatrix(t *testing.T) {
+	t.Run("With matrices of equal shape", func(t *testing.T) {
+		a := mustMatrix(t, mypkg.Vector[float64]{1, 0}, mypkg.Vector[float64]{3, 4})
+		b := mustMatrix(t, mypkg.Vector[float64]{0, 1}, mypkg.Vector[float64]{-2, 1})
+		result, err := mypkg.SumMatrix(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(result).DeepEqual(
+			mustMatrix(t, mypkg.Vector[float64]{1, 1}, mypkg.Vector[float64]{1, 5}),
+		))
+	})
+	t.Run("With matrices of unequal shape", func(t *testing.T) {
+		a := mustNewMatrix(t, 2, 3)
+		b := mustNewMatrix(t, 3, 2)
+		_, err := mypkg.SumMatrix(a, a, b)
+		t.Run("Expect shape error", subtest.Value(err).DeepEqual(mypkg.ShapeError{Index: 2, WantRows: 2, WantCols: 3, GotRows: 3, GotCols: 2}))
+		t.Run("Expect descriptive message", subtest.Value(err).MatchPattern(`^matrix shape mismatch: matrix 2 has shape 3x2, want 2x3$`))
+	})
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	m := mustMatrix(t, mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5, 6})
+	result := m.Transpose()
+	t.Run("Expect transposed matrix", subtest.Value(result).DeepEqual(
+		mustMatrix(t, mypkg.Vector[float64]{1, 4}, mypkg.Vector[float64]{2, 5}, mypkg.Vector[float64]{3, 6}),
+	))
+	t.Run("Expect input is unchanged", subtest.Value(m).DeepEqual(
+		mustMatrix(t, mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5, 6}),
+	))
+}
+
+func TestMatrixMul(t *testing.T) {
+	t.Run("With compatible shapes", func(t *testing.T) {
+		a := mustMatrix(t, mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5, 6})
+		b := mustMatrix(t, mypkg.Vector[float64]{7, 8}, mypkg.Vector[float64]{9, 10}, mypkg.Vector[float64]{11, 12})
+		result, err := a.Mul(b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct product", subtest.Value(result).DeepEqual(
+			mustMatrix(t, mypkg.Vector[float64]{58, 64}, mypkg.Vector[float64]{139, 154}),
+		))
+	})
+	t.Run("With incompatible shapes", func(t *testing.T) {
+		a := mustNewMatrix(t, 2, 3)
+		_, err := a.Mul(a)
+		t.Run("Expect shape error", subtest.Value(err).DeepEqual(mypkg.ShapeError{Index: 1, WantRows: 3, WantCols: 3, GotRows: 2, GotCols: 3}))
+		t.Run("Expect descriptive message", subtest.Value(err).MatchPattern(`^matrix shape mismatch: matrix 1 has shape 2x3, want 3x3$`))
+	})
+}
+
+func TestMatrixCells(t *testing.T) {
+	m := mustMatrix(t, mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{4, 5, 6})
+	t.Run("When ranging over all cells", func(t *testing.T) {
+		var indices []mypkg.Index2
+		var values []float64
+		for ij, v := range m.Cells() {
+			indices = append(indices, ij)
+			values = append(values, v)
+		}
+		t.Run("Expect row-major indices", subtest.Value(indices).DeepEqual([]mypkg.Index2{
+			{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2},
+		}))
+		t.Run("Expect matching values", subtest.Value(values).DeepEqual([]float64{1, 2, 3, 4, 5, 6}))
+	})
+	t.Run("When breaking early", func(t *testing.T) {
+		var n int
+		for range m.Cells() {
+			n++
+			if n == 2 {
+				break
+			}
+		}
+		t.Run("Expect iteration to stop", subtest.Value(n).NumericEqual(2))
+	})
+	t.Run("With a zero matrix", func(t *testing.T) {
+		var n int
+		for range (mypkg.Matrix[float64]{}).Cells() {
+			n++
+		}
+		t.Run("Expect no cells", subtest.Value(n).NumericEqual(0))
+	})
+}
@@ -0,0 +1,72 @@
+package mypkg
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrEmpty is returned by metrics that are undefined for empty input.
+var ErrEmpty = errors.New("empty vector")
+
+// MSE returns the mean squared error between pred and truth; an error is
+// returned if the vectors are empty or have different lengths.
+func MSE[T Real](pred, truth Vector[T]) (float64, error) {
+	if err := checkMetricInput(pred, truth); err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i := range pred {
+		d := float64(pred[i]) - float64(truth[i])
+		sum += d * d
+	}
+	return sum / float64(len(pred)), nil
+}
+
+// MAE returns the mean absolute error between pred and truth; an error is
+// returned if the vectors are empty or have different lengths.
+func MAE[T Real](pred, truth Vector[T]) (float64, error) {
+	if err := checkMetricInput(pred, truth); err != nil {
+		return 0, err
+	}
+	var sum float64
+	for i := range pred {
+		sum += math.Abs(float64(pred[i]) - float64(truth[i]))
+	}
+	return sum / float64(len(pred)), nil
+}
+
+// R2 returns the coefficient of determination (R²) of pred with respect to
+// truth; an error is returned if the vectors are empty or have different
+// lengths. When truth has zero variance, R² is undefined and NaN is returned.
+func R2[T Real](pred, truth Vector[T]) (float64, error) {
+	if err := checkMetricInput(pred, truth); err != nil {
+		return 0, err
+	}
+	var mean float64
+	for _, v := range truth {
+		mean += float64(v)
+	}
+	mean /= float64(len(truth))
+
+	var ssRes, ssTot float64
+	for i := range pred {
+		r := float64(truth[i]) - float64(pred[i])
+		d := float64(truth[i]) - mean
+		ssRes += r * r
+		ssTot += d * d
+	}
+	if ssTot == 0 {
+		return math.NaN(), nil
+	}
+	return 1 - ssRes/ssTot, nil
+}
+
+func checkMetricInput[T Real](pred, truth Vector[T]) error {
+	if err := checkLengths(pred, truth); err != nil {
+		return err
+	}
+	if len(pred) == 0 {
+		return ErrEmpty
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestMSE(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		result, err := mypkg.MSE(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{1, 2, 5})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct result", subtest.Value(result).NumericEqual(4.0/3))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.MSE(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{1, 2})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With empty vectors", func(t *testing.T) {
+		_, err := mypkg.MSE(mypkg.Vector[float64]{}, mypkg.Vector[float64]{})
+		t.Run("Expect ErrEmpty", subtest.Value(err).ErrorIs(mypkg.ErrEmpty))
+	})
+}
+
+func TestMAE(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		result, err := mypkg.MAE(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{1, 4, 2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct result", subtest.Value(result).NumericEqual(1))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.MAE(mypkg.Vector[float64]{1}, mypkg.Vector[float64]{1, 2})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestR2(t *testing.T) {
+	t.Run("With a perfect prediction", func(t *testing.T) {
+		result, err := mypkg.R2(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{1, 2, 3})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect one", subtest.Value(result).NumericEqual(1))
+	})
+	t.Run("With an imperfect prediction", func(t *testing.T) {
+		result, err := mypkg.R2(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{1, 2, 4})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct result", subtest.Value(math.Abs(result-33.0/42)).LessThan(1e-12))
+	})
+	t.Run("With constant truth", func(t *testing.T) {
+		result, err := mypkg.R2(mypkg.Vector[float64]{1, 2, 3}, mypkg.Vector[float64]{2, 2, 2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect NaN", subtest.Value(math.IsNaN(result)).DeepEqual(true))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.R2(mypkg.Vector[float64]{1}, mypkg.Vector[float64]{1, 2})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
@@ -0,0 +1,53 @@
+package mypkg
+
+// Min returns the smallest element of v, or false if v is empty. If v
+// contains a NaN, the first NaN is returned, matching the built-in min.
+func Min[T Real](v Vector[T]) (T, bool) {
+	i, ok := ArgMin(v)
+	if !ok {
+		return 0, false
+	}
+	return v[i], true
+}
+
+// Max returns the largest element of v, or false if v is empty. If v contains
+// a NaN, the first NaN is returned, matching the built-in max.
+func Max[T Real](v Vector[T]) (T, bool) {
+	i, ok := ArgMax(v)
+	if !ok {
+		return 0, false
+	}
+	return v[i], true
+}
+
+// ArgMin returns the index of the smallest element of v, or false if v is
+// empty. On ties, the lowest index is returned. If v contains a NaN, the index
+// of the first NaN is returned.
+func ArgMin[T Real](v Vector[T]) (int, bool) {
+	return argBest(v, func(a, b T) bool { return a < b })
+}
+
+// ArgMax returns the index of the largest element of v, or false if v is
+// empty. On ties, the lowest index is returned. If v contains a NaN, the index
+// of the first NaN is returned.
+func ArgMax[T Real](v Vector[T]) (int, bool) {
+	return argBest(v, func(a, b T) bool { return a > b })
+}
+
+// argBest returns the index of the element preferred by better, keeping the
+// earliest one on ties. The first NaN element, if any, is returned directly.
+func argBest[T Real](v Vector[T], better func(a, b T) bool) (int, bool) {
+	if len(v) == 0 {
+		return -1, false
+	}
+	best := 0
+	for i, x := range v {
+		if x != x {
+			return i, true
+		}
+		if better(x, v[best]) {
+			best = i
+		}
+	}
+	return best, true
+}
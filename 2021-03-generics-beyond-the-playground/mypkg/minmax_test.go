@@ -0,0 +1,39 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestMinMax(t *testing.T) {
+	t.Run("With ties", func(t *testing.T) {
+		v := mypkg.Vector[int]{3, -1, 7, -1, 7}
+		minV, minOK := mypkg.Min(v)
+		maxV, maxOK := mypkg.Max(v)
+		argMin, _ := mypkg.ArgMin(v)
+		argMax, _ := mypkg.ArgMax(v)
+		t.Run("Expect ok", subtest.Value([]bool{minOK, maxOK}).DeepEqual([]bool{true, true}))
+		t.Run("Expect minimum", subtest.Value(minV).NumericEqual(-1))
+		t.Run("Expect maximum", subtest.Value(maxV).NumericEqual(7))
+		t.Run("Expect first index of minimum", subtest.Value(argMin).NumericEqual(1))
+		t.Run("Expect first index of maximum", subtest.Value(argMax).NumericEqual(2))
+	})
+	t.Run("With an empty vector", func(t *testing.T) {
+		_, minOK := mypkg.Min(mypkg.Vector[float64]{})
+		i, argOK := mypkg.ArgMax(mypkg.Vector[float64](nil))
+		t.Run("Expect not ok", subtest.Value([]bool{minOK, argOK}).DeepEqual([]bool{false, false}))
+		t.Run("Expect index -1", subtest.Value(i).NumericEqual(-1))
+	})
+	t.Run("With a NaN element", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, math.NaN(), -5, math.NaN()}
+		minV, _ := mypkg.Min(v)
+		argMin, _ := mypkg.ArgMin(v)
+		argMax, _ := mypkg.ArgMax(v)
+		t.Run("Expect NaN minimum", subtest.Value(math.IsNaN(minV)).DeepEqual(true))
+		t.Run("Expect index of first NaN from ArgMin", subtest.Value(argMin).NumericEqual(1))
+		t.Run("Expect index of first NaN from ArgMax", subtest.Value(argMax).NumericEqual(1))
+	})
+}
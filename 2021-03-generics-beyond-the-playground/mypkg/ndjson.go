@@ -0,0 +1,51 @@
+package mypkg
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// ReadNDJSON returns an iterator over vectors read from r, where each line
+// holds one vector encoded as a JSON array. Blank lines are skipped. On a read
+// or decode error, the error is yielded together with a nil vector, and the
+// iteration stops.
+func ReadNDJSON[T Real](r io.Reader) iter.Seq2[Vector[T], error] {
+	return func(yield func(Vector[T], error) bool) {
+		br := bufio.NewReader(r)
+		for line := 1; ; line++ {
+			b, err := br.ReadBytes('\n')
+			if len(bytes.TrimSpace(b)) > 0 {
+				var v Vector[T]
+				if err := json.Unmarshal(b, &v); err != nil {
+					yield(nil, fmt.Errorf("line %d: %w", line, err))
+					return
+				}
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				yield(nil, fmt.Errorf("line %d: %w", line, err))
+				return
+			}
+		}
+	}
+}
+
+// WriteNDJSON writes v to w as a JSON array followed by a newline.
+func WriteNDJSON[T Real](w io.Writer, v Vector[T]) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
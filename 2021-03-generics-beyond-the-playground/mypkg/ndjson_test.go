@@ -0,0 +1,48 @@
+package mypkg_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestReadNDJSON(t *testing.T) {
+	t.Run("With valid input", func(t *testing.T) {
+		r := strings.NewReader("[1, 0, 3]\n\n[0, 1, -2]")
+		var result []mypkg.Vector[float64]
+		var errs []error
+		for v, err := range mypkg.ReadNDJSON[float64](r) {
+			result = append(result, v)
+			errs = append(errs, err)
+		}
+		t.Run("Expect no errors", subtest.Value(errs).DeepEqual([]error{nil, nil}))
+		t.Run("Expect vectors in order", subtest.Value(result).DeepEqual([]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2}}))
+	})
+	t.Run("With invalid line", func(t *testing.T) {
+		r := strings.NewReader("[1, 0, 3]\n[0, x]\n[1]\n")
+		var n int
+		var lastErr error
+		for _, err := range mypkg.ReadNDJSON[float64](r) {
+			n++
+			lastErr = err
+		}
+		t.Run("Expect iteration to stop at the error", subtest.Value(n).NumericEqual(2))
+		t.Run("Expect error with line number", subtest.Value(lastErr).MatchPattern(`^line 2: `))
+	})
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var sb strings.Builder
+	err1 := mypkg.WriteNDJSON(&sb, mypkg.Vector[float64]{1, 0, 3})
+	err2 := mypkg.WriteNDJSON(&sb, mypkg.Vector[float64]{0.5, -2})
+	t.Run("Expect no errors", subtest.Value([]error{err1, err2}).DeepEqual([]error{nil, nil}))
+	t.Run("Expect one vector per line", subtest.Value(sb.String()).DeepEqual("[1,0,3]\n[0.5,-2]\n"))
+	t.Run("With uint8 vector", func(t *testing.T) {
+		var sb strings.Builder
+		err := mypkg.WriteNDJSON(&sb, mypkg.Vector[uint8]{1, 2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect JSON array", subtest.Value(sb.String()).DeepEqual("[1,2]\n"))
+	})
+}
@@ -0,0 +1,53 @@
+package mypkg
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrZeroVector is returned when normalizing a vector with a norm of zero,
+// including an empty vector.
+var ErrZeroVector = errors.New("zero vector")
+
+// Normalize returns a new unit vector with the same direction as v. If v has a
+// norm of zero, ErrZeroVector is returned.
+func Normalize[T Float](v Vector[T]) (Vector[T], error) {
+	target := make(Vector[T], len(v))
+	if err := normalizeInto(target, v); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// NormalizeInto writes the unit vector with the same direction as v to dst;
+// an error is returned if dst and v have different lengths, or ErrZeroVector
+// if v has a norm of zero. Passing v as dst normalizes v in place.
+func NormalizeInto[T Float](dst, v Vector[T]) error {
+	if len(dst) != len(v) {
+		return DimensionError{Index: 0, Want: len(v), Got: len(dst)}
+	}
+	return normalizeInto(dst, v)
+}
+
+// normalizeInto divides by the largest magnitude before squaring, so that the
+// norm neither overflows nor underflows for very large or very small
+// elements.
+func normalizeInto[T Float](dst, v Vector[T]) error {
+	var scale float64
+	for _, x := range v {
+		scale = max(scale, math.Abs(float64(x)))
+	}
+	if scale == 0 {
+		return ErrZeroVector
+	}
+	var sum float64
+	for _, x := range v {
+		y := float64(x) / scale
+		sum += y * y
+	}
+	norm := scale * math.Sqrt(sum)
+	for i, x := range v {
+		dst[i] = T(float64(x) / norm)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package mypkg_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Run("With a non-zero vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{3, 0, -4}
+		u, err := mypkg.Normalize(v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect unit vector", subtest.Value(u).DeepEqual(mypkg.Vector[float64]{0.6, 0, -0.8}))
+		t.Run("Expect input unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{3, 0, -4}))
+	})
+	t.Run("With tiny elements", func(t *testing.T) {
+		u, err := mypkg.Normalize(mypkg.Vector[float64]{3e-200, 4e-200})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect unit norm", subtest.Value(math.Abs(u.Norm()-1)).LessThan(1e-15))
+	})
+	t.Run("With huge elements", func(t *testing.T) {
+		u, err := mypkg.Normalize(mypkg.Vector[float64]{3e200, 4e200})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect unit norm", subtest.Value(math.Abs(u.Norm()-1)).LessThan(1e-15))
+	})
+	t.Run("With a zero vector", func(t *testing.T) {
+		u, err := mypkg.Normalize(mypkg.Vector[float64]{0, 0})
+		t.Run("Expect ErrZeroVector", subtest.Value(err).ErrorIs(mypkg.ErrZeroVector))
+		t.Run("Expect nil result", subtest.Value(u).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+	t.Run("With an empty vector", func(t *testing.T) {
+		_, err := mypkg.Normalize(mypkg.Vector[float64]{})
+		t.Run("Expect ErrZeroVector", subtest.Value(err).ErrorIs(mypkg.ErrZeroVector))
+	})
+}
+
+func TestNormalizeInto(t *testing.T) {
+	t.Run("With v as dst", func(t *testing.T) {
+		v := mypkg.Vector[float64]{0, 2}
+		err := mypkg.NormalizeInto(v, v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect v normalized in place", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{0, 1}))
+	})
+	t.Run("With a zero vector", func(t *testing.T) {
+		dst := mypkg.Vector[float64]{7, 7}
+		err := mypkg.NormalizeInto(dst, mypkg.Vector[float64]{0, 0})
+		t.Run("Expect ErrZeroVector", subtest.Value(err).ErrorIs(mypkg.ErrZeroVector))
+		t.Run("Expect dst unchanged", subtest.Value(dst).DeepEqual(mypkg.Vector[float64]{7, 7}))
+	})
+	t.Run("With dst of wrong length", func(t *testing.T) {
+		err := mypkg.NormalizeInto(make(mypkg.Vector[float64], 1), mypkg.Vector[float64]{1, 2})
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 0, Want: 2, Got: 1}))
+	})
+	t.Run("With preallocated dst", func(t *testing.T) {
+		v, dst := mypkg.Vector[float64]{1, 2, 3}, make(mypkg.Vector[float64], 3)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = mypkg.NormalizeInto(dst, v)
+		})
+		t.Run("Expect no allocations", subtest.Value(allocs).NumericEqual(0))
+	})
+}
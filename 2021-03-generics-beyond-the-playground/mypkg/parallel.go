@@ -0,0 +1,50 @@
+package mypkg
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SumParallel returns the sum of multiple vectors of the same length, like Sum,
+// but splits the work across up to workers goroutines. If workers is zero or
+// less, runtime.GOMAXPROCS(0) is used.
+//
+// Each goroutine sums a contiguous range of element indices across all
+// vectors, in order, so the result is identical for any number of workers.
+func SumParallel[T Number](workers int, vectors ...Vector[T]) (Vector[T], error) {
+	if len(vectors) == 0 {
+		return nil, nil
+	}
+	if err := checkLengths(vectors...); err != nil {
+		return nil, err
+	}
+	l := len(vectors[0])
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers > l {
+		workers = l
+	}
+	target := make(Vector[T], l)
+	if workers == 0 {
+		return target, nil
+	}
+
+	chunk := (l + workers - 1) / workers
+	var wg sync.WaitGroup
+	for start := 0; start < l; start += chunk {
+		end := min(start+chunk, l)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dst := target[start:end]
+			for _, v := range vectors {
+				for i, x := range v[start:end] {
+					dst[i] += x
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return target, nil
+}
@@ -0,0 +1,71 @@
+package mypkg_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSumParallel(t *testing.T) {
+	vectors := make([]mypkg.Vector[float64], 100)
+	for i := range vectors {
+		vectors[i] = mypkg.Vector[float64]{float64(i), 0.1, -1, 1e-3, float64(i) / 7}
+	}
+	expect := make(mypkg.Vector[float64], 5)
+	for _, v := range vectors {
+		for i, x := range v {
+			expect[i] += x
+		}
+	}
+
+	for _, workers := range []int{0, 1, 2, 3, 16} {
+		t.Run(fmt.Sprintf("With %d workers", workers), func(t *testing.T) {
+			result, err := mypkg.SumParallel(workers, vectors...)
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect result identical to serial summation", subtest.Value(result).DeepEqual(expect))
+		})
+	}
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.SumParallel(2, mypkg.Vector[float64]{1, 2}, mypkg.Vector[float64]{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With empty vectors", func(t *testing.T) {
+		result, err := mypkg.SumParallel(2, mypkg.Vector[float64]{}, mypkg.Vector[float64]{})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect empty result", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{}))
+	})
+}
+
+func benchmarkVectors(n, l int) []mypkg.Vector[float64] {
+	vectors := make([]mypkg.Vector[float64], n)
+	for i := range vectors {
+		v := make(mypkg.Vector[float64], l)
+		for j := range v {
+			v[j] = float64(i + j)
+		}
+		vectors[i] = v
+	}
+	return vectors
+}
+
+func BenchmarkSum_serial(b *testing.B) {
+	vectors := benchmarkVectors(1000, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.Sum(vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSum_parallel(b *testing.B) {
+	vectors := benchmarkVectors(1000, 10000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.SumParallel(0, vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package mypkg
+
+// Scale returns a new vector with each element of v multiplied by k.
+func (v Vector[T]) Scale(k T) Vector[T] {
+	target := make(Vector[T], len(v))
+	v.scaleInto(target, k)
+	return target
+}
+
+// ScaleInto writes each element of v multiplied by k to dst; an error is
+// returned if dst and v have different lengths. Passing v as dst scales v in
+// place.
+func (v Vector[T]) ScaleInto(dst Vector[T], k T) error {
+	if len(dst) != len(v) {
+		return DimensionError{Index: 0, Want: len(v), Got: len(dst)}
+	}
+	v.scaleInto(dst, k)
+	return nil
+}
+
+func (v Vector[T]) scaleInto(dst Vector[T], k T) {
+	for i, x := range v {
+		dst[i] = x * k
+	}
+}
+
+// AddScalar returns a new vector with k added to each element of v.
+func (v Vector[T]) AddScalar(k T) Vector[T] {
+	target := make(Vector[T], len(v))
+	v.addScalarInto(target, k)
+	return target
+}
+
+// AddScalarInto writes each element of v plus k to dst; an error is returned
+// if dst and v have different lengths. Passing v as dst updates v in place.
+func (v Vector[T]) AddScalarInto(dst Vector[T], k T) error {
+	if len(dst) != len(v) {
+		return DimensionError{Index: 0, Want: len(v), Got: len(dst)}
+	}
+	v.addScalarInto(dst, k)
+	return nil
+}
+
+func (v Vector[T]) addScalarInto(dst Vector[T], k T) {
+	for i, x := range v {
+		dst[i] = x + k
+	}
+}
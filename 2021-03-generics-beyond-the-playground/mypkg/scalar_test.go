@@ -0,0 +1,49 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestVectorScale(t *testing.T) {
+	t.Run("When calling Scale", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		result := v.Scale(2)
+		t.Run("Expect scaled vector", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{2, 0, -6}))
+		t.Run("Expect input is unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 0, -3}))
+	})
+	t.Run("When calling ScaleInto with v as dst", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		err := v.ScaleInto(v, 2)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect v to be scaled in place", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{2, 0, -6}))
+	})
+	t.Run("When calling ScaleInto with dst of unequal length", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		err := v.ScaleInto(make(mypkg.Vector[float64], 2), 2)
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestVectorAddScalar(t *testing.T) {
+	t.Run("When calling AddScalar", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		result := v.AddScalar(1.5)
+		t.Run("Expect shifted vector", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{2.5, 1.5, -1.5}))
+		t.Run("Expect input is unchanged", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 0, -3}))
+	})
+	t.Run("When calling AddScalarInto with a separate dst", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		dst := make(mypkg.Vector[float64], 3)
+		err := v.AddScalarInto(dst, 1)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect dst to hold the result", subtest.Value(dst).DeepEqual(mypkg.Vector[float64]{2, 1, -2}))
+	})
+	t.Run("When calling AddScalarInto with dst of unequal length", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, -3}
+		err := v.AddScalarInto(nil, 1)
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
@@ -0,0 +1,204 @@
+package mypkg
+
+import (
+	"fmt"
+	"iter"
+)
+
+// SparseVector is a vector of length Len where only non-zero elements are
+// stored, as index/value pairs sorted by index. The zero value is an empty
+// vector of length 0.
+type SparseVector[T Number] struct {
+	n       int
+	indices []int
+	values  []T
+}
+
+// NewSparseVector returns a sparse vector of length n holding values at the
+// given indices. An error is returned if n is negative, if indices and values
+// have different lengths, or if the indices are not strictly increasing and in
+// range. Zero values are not stored.
+func NewSparseVector[T Number](n int, indices []int, values []T) (SparseVector[T], error) {
+	if n < 0 {
+		return SparseVector[T]{}, fmt.Errorf("negative sparse vector length %d", n)
+	}
+	if len(indices) != len(values) {
+		return SparseVector[T]{}, DimensionError{Index: 2, Want: len(indices), Got: len(values)}
+	}
+	s := SparseVector[T]{n: n}
+	prev := -1
+	for k, i := range indices {
+		if i <= prev || i >= n {
+			return SparseVector[T]{}, fmt.Errorf("sparse index %d at position %d is out of order or range [0:%d]", i, k, n)
+		}
+		prev = i
+		if values[k] != 0 {
+			s.indices = append(s.indices, i)
+			s.values = append(s.values, values[k])
+		}
+	}
+	return s, nil
+}
+
+// SparseFromDense returns a sparse copy of v, storing only non-zero elements.
+func SparseFromDense[T Number](v Vector[T]) SparseVector[T] {
+	s := SparseVector[T]{n: len(v)}
+	for i, x := range v {
+		if x != 0 {
+			s.indices = append(s.indices, i)
+			s.values = append(s.values, x)
+		}
+	}
+	return s
+}
+
+// Len returns the length of s, including zero elements.
+func (s SparseVector[T]) Len() int {
+	return s.n
+}
+
+// NNZ returns the number of stored, non-zero elements in s.
+func (s SparseVector[T]) NNZ() int {
+	return len(s.indices)
+}
+
+// All returns an iterator over the index and value of each stored element in
+// s, in increasing index order. It matches Vector.All, except that zero
+// elements are skipped.
+func (s SparseVector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for k, i := range s.indices {
+			if !yield(i, s.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Dense returns a dense copy of s.
+func (s SparseVector[T]) Dense() Vector[T] {
+	target := make(Vector[T], s.n)
+	for i, x := range s.All() {
+		target[i] = x
+	}
+	return target
+}
+
+// AddTo adds s to the dense vector dst in place; a DimensionError is returned
+// if dst has a different length then s. Summing many sparse vectors into one
+// dense accumulator this way only touches the non-zero elements.
+func (s SparseVector[T]) AddTo(dst Vector[T]) error {
+	if len(dst) != s.n {
+		return DimensionError{Index: 0, Want: s.n, Got: len(dst)}
+	}
+	for i, x := range s.All() {
+		dst[i] += x
+	}
+	return nil
+}
+
+// SumSparse returns the sum of multiple sparse vectors of the same length; a
+// DimensionError is returned if one of the vectors has a different length
+// then the others. Elements that cancel out to zero are not stored.
+func SumSparse[T Number](vectors ...SparseVector[T]) (SparseVector[T], error) {
+	if len(vectors) == 0 {
+		return SparseVector[T]{}, nil
+	}
+	n := vectors[0].n
+	for i, v := range vectors[1:] {
+		if v.n != n {
+			return SparseVector[T]{}, DimensionError{Index: i + 1, Want: n, Got: v.n}
+		}
+	}
+	// Merge pairwise in rounds, so that each stored element is copied
+	// O(log(len(vectors))) times rather then O(len(vectors)) times.
+	merged := append([]SparseVector[T](nil), vectors...)
+	for len(merged) > 1 {
+		next := merged[:0]
+		for i := 0; i < len(merged); i += 2 {
+			if i+1 == len(merged) {
+				next = append(next, merged[i])
+				break
+			}
+			next = append(next, mergeSparse(merged[i], merged[i+1]))
+		}
+		merged = next
+	}
+	if len(vectors) == 1 {
+		// Avoid sharing storage with the input.
+		return mergeSparse(SparseVector[T]{n: n}, merged[0]), nil
+	}
+	return merged[0], nil
+}
+
+// mergeSparse returns the sum of a and b, which must have the same length.
+func mergeSparse[T Number](a, b SparseVector[T]) SparseVector[T] {
+	target := SparseVector[T]{
+		n:       a.n,
+		indices: make([]int, 0, len(a.indices)+len(b.indices)),
+		values:  make([]T, 0, len(a.values)+len(b.values)),
+	}
+	add := func(i int, x T) {
+		if x != 0 {
+			target.indices = append(target.indices, i)
+			target.values = append(target.values, x)
+		}
+	}
+	var ka, kb int
+	for ka < len(a.indices) && kb < len(b.indices) {
+		switch ia, ib := a.indices[ka], b.indices[kb]; {
+		case ia < ib:
+			add(ia, a.values[ka])
+			ka++
+		case ib < ia:
+			add(ib, b.values[kb])
+			kb++
+		default:
+			add(ia, a.values[ka]+b.values[kb])
+			ka++
+			kb++
+		}
+	}
+	for ; ka < len(a.indices); ka++ {
+		add(a.indices[ka], a.values[ka])
+	}
+	for ; kb < len(b.indices); kb++ {
+		add(b.indices[kb], b.values[kb])
+	}
+	return target
+}
+
+// DotSparse returns the dot product of a and b, with the same semantics as Dot;
+// a DimensionError is returned if the vectors have different lengths.
+func DotSparse[T Number](a, b SparseVector[T]) (T, error) {
+	if a.n != b.n {
+		return 0, DimensionError{Index: 1, Want: a.n, Got: b.n}
+	}
+	// For complex types, collect the overlapping elements and let Dot handle
+	// the conjugation.
+	conj := isComplex[T]()
+	var x, y Vector[T]
+	var sum T
+	var ka, kb int
+	for ka < len(a.indices) && kb < len(b.indices) {
+		switch ia, ib := a.indices[ka], b.indices[kb]; {
+		case ia < ib:
+			ka++
+		case ib < ia:
+			kb++
+		default:
+			if conj {
+				x = append(x, a.values[ka])
+				y = append(y, b.values[kb])
+			} else {
+				sum += a.values[ka] * b.values[kb]
+			}
+			ka++
+			kb++
+		}
+	}
+	if conj {
+		return Dot(x, y)
+	}
+	return sum, nil
+}
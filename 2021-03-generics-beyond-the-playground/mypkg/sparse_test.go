@@ -0,0 +1,179 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestNewSparseVector(t *testing.T) {
+	t.Run("With valid indices", func(t *testing.T) {
+		s, err := mypkg.NewSparseVector(5, []int{1, 3, 4}, []float64{2, 0, -1})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct length", subtest.Value(s.Len()).NumericEqual(5))
+		t.Run("Expect zero values not to be stored", subtest.Value(s.NNZ()).NumericEqual(2))
+		t.Run("Expect correct dense vector", subtest.Value(s.Dense()).DeepEqual(mypkg.Vector[float64]{0, 2, 0, 0, -1}))
+	})
+	t.Run("With unsorted indices", func(t *testing.T) {
+		_, err := mypkg.NewSparseVector(5, []int{3, 1}, []float64{1, 1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With index out of range", func(t *testing.T) {
+		_, err := mypkg.NewSparseVector(5, []int{5}, []float64{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With negative length", func(t *testing.T) {
+		_, err := mypkg.NewSparseVector(-1, nil, []float64{})
+		t.Run("Expect an error", subtest.Value(err).MatchPattern(`^negative sparse vector length -1$`))
+	})
+	t.Run("With more indices then values", func(t *testing.T) {
+		_, err := mypkg.NewSparseVector(5, []int{1, 2}, []float64{1})
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 2, Want: 2, Got: 1}))
+	})
+}
+
+func TestSparseFromDense(t *testing.T) {
+	v := mypkg.Vector[float64]{0, 1, 0, 3}
+	s := mypkg.SparseFromDense(v)
+	t.Run("Expect only non-zero elements to be stored", subtest.Value(s.NNZ()).NumericEqual(2))
+	t.Run("Expect dense round-trip", subtest.Value(s.Dense()).DeepEqual(v))
+}
+
+func TestSparseVectorAll(t *testing.T) {
+	s := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 1, 0, 3, 5})
+	t.Run("When ranging over all stored elements", func(t *testing.T) {
+		var indices []int
+		var values []float64
+		for i, x := range s.All() {
+			indices = append(indices, i)
+			values = append(values, x)
+		}
+		t.Run("Expect non-zero indices in order", subtest.Value(indices).DeepEqual([]int{1, 3, 4}))
+		t.Run("Expect matching values", subtest.Value(values).DeepEqual([]float64{1, 3, 5}))
+	})
+	t.Run("When breaking early", func(t *testing.T) {
+		var n int
+		for range s.All() {
+			n++
+			if n == 2 {
+				break
+			}
+		}
+		t.Run("Expect iteration to stop", subtest.Value(n).NumericEqual(2))
+	})
+}
+
+func TestSumSparse(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		a := mypkg.SparseFromDense(mypkg.Vector[float64]{1, 0, 3, 0})
+		b := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 1, -3, 0})
+		c := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 0, 0, 2})
+		result, err := mypkg.SumSparse(a, b, c)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(result.Dense()).DeepEqual(mypkg.Vector[float64]{1, 1, 0, 2}))
+		t.Run("Expect cancelled elements not to be stored", subtest.Value(result.NNZ()).NumericEqual(3))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		a := mypkg.SparseFromDense(mypkg.Vector[float64]{1, 0, 3})
+		b := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 1})
+		_, err := mypkg.SumSparse(a, b)
+		t.Run("Expect dimension error", subtest.Value(err).DeepEqual(mypkg.DimensionError{Index: 1, Want: 3, Got: 2}))
+	})
+}
+
+func TestSparseVectorAddTo(t *testing.T) {
+	t.Run("With dst of equal length", func(t *testing.T) {
+		dst := mypkg.Vector[float64]{1, 1, 1}
+		err := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 2, 0}).AddTo(dst)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect dst to hold the mixed sum", subtest.Value(dst).DeepEqual(mypkg.Vector[float64]{1, 3, 1}))
+	})
+	t.Run("With dst of unequal length", func(t *testing.T) {
+		err := mypkg.SparseFromDense(mypkg.Vector[float64]{0, 2, 0}).AddTo(make(mypkg.Vector[float64], 2))
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+func TestDotSparse(t *testing.T) {
+	t.Run("With float64 vectors", func(t *testing.T) {
+		a := mypkg.SparseFromDense(mypkg.Vector[float64]{1, 0, 3, 4})
+		b := mypkg.SparseFromDense(mypkg.Vector[float64]{2, 5, -2, 0})
+		result, err := mypkg.DotSparse(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct dot product", subtest.Value(result).NumericEqual(-4))
+	})
+	t.Run("With complex128 vectors", func(t *testing.T) {
+		a := mypkg.SparseFromDense(mypkg.Vector[complex128]{1 + 2i, 0, 3})
+		b := mypkg.SparseFromDense(mypkg.Vector[complex128]{4, 1, 1i})
+		result, err := mypkg.DotSparse(a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect same result as Dot", subtest.Value(result).DeepEqual(complex128(4-5i)))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		a := mypkg.SparseFromDense(mypkg.Vector[float64]{1, 0, 3})
+		b := mypkg.SparseFromDense(mypkg.Vector[float64]{1})
+		_, err := mypkg.DotSparse(a, b)
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}
+
+// sparseBenchmarkVectors returns n vectors of length l where every 100th
+// element is non-zero.
+func sparseBenchmarkVectors(n, l int) []mypkg.Vector[float64] {
+	vectors := make([]mypkg.Vector[float64], n)
+	for i := range vectors {
+		v := make(mypkg.Vector[float64], l)
+		for j := i % 100; j < l; j += 100 {
+			v[j] = float64(i + j)
+		}
+		vectors[i] = v
+	}
+	return vectors
+}
+
+func BenchmarkSumInto_denseMostlyZero(b *testing.B) {
+	vectors := sparseBenchmarkVectors(100, 100000)
+	dst := make(mypkg.Vector[float64], 100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := mypkg.SumInto(dst, vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSparseVectorAddTo(b *testing.B) {
+	dense := sparseBenchmarkVectors(100, 100000)
+	vectors := make([]mypkg.SparseVector[float64], len(dense))
+	for i, v := range dense {
+		vectors[i] = mypkg.SparseFromDense(v)
+	}
+	dst := make(mypkg.Vector[float64], 100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		clear(dst)
+		for _, s := range vectors {
+			if err := s.AddTo(dst); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkSumSparse(b *testing.B) {
+	dense := sparseBenchmarkVectors(100, 100000)
+	vectors := make([]mypkg.SparseVector[float64], len(dense))
+	for i, v := range dense {
+		vectors[i] = mypkg.SparseFromDense(v)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.SumSparse(vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package mypkg_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+	"gopkg.in/yaml.v3"
+)
+
+// specFuncs maps spec file names in testdata/specs to the function under test.
+var specFuncs = map[string]func(...mypkg.Vector[float64]) (mypkg.Vector[float64], error){
+	"sum": mypkg.Sum[float64],
+}
+
+// specCase describes a single test case in a spec file. When Error is set, it
+// holds a regular expression the returned error must match, and Expect is
+// ignored. When KnownFailure is set, it holds the reason the case is expected
+// to fail; the case is skipped if it fails, and reported as an error if it
+// unexpectedly passes.
+type specCase struct {
+	Name         string                  `yaml:"name"`
+	Vectors      []mypkg.Vector[float64] `yaml:"vectors"`
+	Expect       mypkg.Vector[float64]   `yaml:"expect"`
+	Error        string                  `yaml:"error"`
+	KnownFailure string                  `yaml:"known_failure"`
+}
+
+// specCheck is a named check against a single value from the function under
+// test.
+type specCheck struct {
+	name  string
+	value interface{}
+	check subtest.CheckFunc
+}
+
+func TestSpecs(t *testing.T) {
+	// YAML is a superset of JSON, so .json spec files decode the same way.
+	paths, err := filepath.Glob(filepath.Join("testdata", "specs", "*.*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		ext := filepath.Ext(path)
+		if ext != ".yaml" && ext != ".yml" && ext != ".json" {
+			continue
+		}
+		name := strings.TrimSuffix(filepath.Base(path), ext)
+		t.Run(name, func(t *testing.T) {
+			f, ok := specFuncs[name]
+			if !ok {
+				t.Fatalf("no function registered for spec %q", path)
+			}
+			cases := readSpec(t, path)
+			for _, c := range cases {
+				t.Run(c.Name, func(t *testing.T) {
+					result, err := f(c.Vectors...)
+					checks := []specCheck{
+						{"Expect no error", err, subtest.NoError()},
+						{"Expect correct result", result, subtest.DeepEqual(c.Expect)},
+					}
+					if c.Error != "" {
+						checks = []specCheck{{"Expect matching error", err, subtest.MatchPattern(c.Error)}}
+					}
+					if c.KnownFailure != "" {
+						for _, sc := range checks {
+							if sc.check(sc.value) != nil {
+								t.Skipf("known failure: %s", c.KnownFailure)
+							}
+						}
+						t.Fatalf("case passes, but is marked as a known failure (%s); remove known_failure from the spec", c.KnownFailure)
+					}
+					for _, sc := range checks {
+						t.Run(sc.name, subtest.Value(sc.value).Test(sc.check))
+					}
+				})
+			}
+		})
+	}
+}
+
+func readSpec(t *testing.T, path string) []specCase {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cases []specCase
+	if err := yaml.Unmarshal(b, &cases); err != nil {
+		t.Fatalf("invalid spec %q: %v", path, err)
+	}
+	return cases
+}
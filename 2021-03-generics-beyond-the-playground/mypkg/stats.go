@@ -0,0 +1,82 @@
+package mypkg
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrNoVectors is returned by statistics that are undefined when called
+// without any vectors.
+var ErrNoVectors = errors.New("no vectors")
+
+// Mean returns the element-wise mean of multiple vectors of the same length.
+// An error is returned if no vectors are given, or if the lengths differ.
+func Mean[T Float](vectors ...Vector[T]) (Vector[T], error) {
+	if err := checkStatsInput(vectors); err != nil {
+		return nil, err
+	}
+	return mean(vectors), nil
+}
+
+// Variance returns the element-wise population variance of multiple vectors
+// of the same length, i.e. the mean squared deviation from Mean. An error is
+// returned if no vectors are given, or if the lengths differ.
+func Variance[T Float](vectors ...Vector[T]) (Vector[T], error) {
+	if err := checkStatsInput(vectors); err != nil {
+		return nil, err
+	}
+	return variance(vectors), nil
+}
+
+// StdDev returns the element-wise population standard deviation of multiple
+// vectors of the same length, i.e. the square root of Variance. An error is
+// returned if no vectors are given, or if the lengths differ.
+func StdDev[T Float](vectors ...Vector[T]) (Vector[T], error) {
+	if err := checkStatsInput(vectors); err != nil {
+		return nil, err
+	}
+	target := variance(vectors)
+	for i, v := range target {
+		target[i] = T(math.Sqrt(float64(v)))
+	}
+	return target, nil
+}
+
+func checkStatsInput[T Float](vectors []Vector[T]) error {
+	if len(vectors) == 0 {
+		return ErrNoVectors
+	}
+	return checkLengths(vectors...)
+}
+
+func mean[T Float](vectors []Vector[T]) Vector[T] {
+	target := make(Vector[T], len(vectors[0]))
+	for _, v := range vectors {
+		for i := range v {
+			target[i] += v[i]
+		}
+	}
+	n := T(len(vectors))
+	for i := range target {
+		target[i] /= n
+	}
+	return target
+}
+
+// variance uses a two-pass algorithm, summing squared deviations from the
+// mean, which avoids the cancellation of the sum-of-squares formula.
+func variance[T Float](vectors []Vector[T]) Vector[T] {
+	m := mean(vectors)
+	target := make(Vector[T], len(m))
+	for _, v := range vectors {
+		for i := range v {
+			d := v[i] - m[i]
+			target[i] += d * d
+		}
+	}
+	n := T(len(vectors))
+	for i := range target {
+		target[i] /= n
+	}
+	return target
+}
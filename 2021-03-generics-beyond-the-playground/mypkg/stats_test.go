@@ -0,0 +1,70 @@
+package mypkg_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestMean(t *testing.T) {
+	t.Run("With three vectors", func(t *testing.T) {
+		m, err := mypkg.Mean(
+			mypkg.Vector[float64]{1, 0, 4},
+			mypkg.Vector[float64]{2, 0, -4},
+			mypkg.Vector[float64]{3, 0, 3},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect element-wise mean", subtest.Value(m).DeepEqual(mypkg.Vector[float64]{2, 0, 1}))
+	})
+	t.Run("With no vectors", func(t *testing.T) {
+		_, err := mypkg.Mean[float64]()
+		t.Run("Expect ErrNoVectors", subtest.Value(err).ErrorIs(mypkg.ErrNoVectors))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Mean(mypkg.Vector[float32]{1, 2}, mypkg.Vector[float32]{1})
+		var dimErr mypkg.DimensionError
+		t.Run("Expect DimensionError", subtest.Value(errors.As(err, &dimErr)).DeepEqual(true))
+		t.Run("Expect index of offending vector", subtest.Value(dimErr.Index).NumericEqual(1))
+	})
+}
+
+func TestVariance(t *testing.T) {
+	t.Run("With three vectors", func(t *testing.T) {
+		v, err := mypkg.Variance(
+			mypkg.Vector[float64]{1, 5},
+			mypkg.Vector[float64]{2, 5},
+			mypkg.Vector[float64]{3, 5},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect population variance", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{2.0 / 3, 0}))
+	})
+	t.Run("With a large offset", func(t *testing.T) {
+		v, err := mypkg.Variance(
+			mypkg.Vector[float64]{1e9 + 1},
+			mypkg.Vector[float64]{1e9 + 3},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect precise variance", subtest.Value(v[0]).NumericEqual(1))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		_, err := mypkg.Variance(mypkg.Vector[float64]{1}, mypkg.Vector[float64]{1, 2})
+		t.Run("Expect error", subtest.Value(err).MatchPattern(`^vector lengths unequal`))
+	})
+}
+
+func TestStdDev(t *testing.T) {
+	t.Run("With two vectors", func(t *testing.T) {
+		s, err := mypkg.StdDev(
+			mypkg.Vector[float64]{1, -2},
+			mypkg.Vector[float64]{5, 2},
+		)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect population standard deviation", subtest.Value(s).DeepEqual(mypkg.Vector[float64]{2, 2}))
+	})
+	t.Run("With no vectors", func(t *testing.T) {
+		_, err := mypkg.StdDev[float64]()
+		t.Run("Expect ErrNoVectors", subtest.Value(err).ErrorIs(mypkg.ErrNoVectors))
+	})
+}
@@ -10,9 +10,9 @@ import (
 )
 
 func TestSum(t *testing.T) {
-	a := mypkg.Vector{1, 0, 3}
-	b := mypkg.Vector{0, 1, -2}
-	expect := mypkg.Vector{1, 1, 1}
+	a := mypkg.Vector[float64]{1, 0, 3}
+	b := mypkg.Vector[float64]{0, 1, -2}
+	expect := mypkg.Vector[float64]{1, 1, 1}
 
 	result, err := mypkg.Sum(a, b)
 
@@ -25,9 +25,9 @@ func TestSum(t *testing.T) {
 }
 
 func TestSum_assert(t *testing.T) {
-	a := mypkg.Vector{1, 0, 3}
-	b := mypkg.Vector{0, 1, -2}
-	expect := mypkg.Vector{1, 1, 1}
+	a := mypkg.Vector[float64]{1, 0, 3}
+	b := mypkg.Vector[float64]{0, 1, -2}
+	expect := mypkg.Vector[float64]{1, 1, 1}
 
 	result, err := mypkg.Sum(a, b)
 
@@ -36,12 +36,24 @@ func TestSum_assert(t *testing.T) {
 }
 
 func TestSum_subtest(t *testing.T) {
-	a := mypkg.Vector{1, 0, 3}
-	b := mypkg.Vector{0, 1, -2}
-	expect := mypkg.Vector{1, 1, 1}
+	a := mypkg.Vector[float64]{1, 0, 3}
+	b := mypkg.Vector[float64]{0, 1, -2}
+	expect := mypkg.Vector[float64]{1, 1, 1}
 
 	result, err := mypkg.Sum(a, b)
 
 	t.Run("Expect no error", subtest.Value(err).NoError())
 	t.Run("Expect correct sum", subtest.Value(result).DeepEqual(expect))
 }
+
+func TestSum_dimensionError(t *testing.T) {
+	a := mypkg.Vector[float64]{1, 0, 3}
+	b := mypkg.Vector[float64]{0, 1, -2}
+	c := mypkg.Vector[float64]{1, 1}
+
+	_, err := mypkg.Sum(a, b, c)
+
+	t.Run("Expect dimension error for c", subtest.Value(err).DeepEqual(
+		mypkg.DimensionError{Index: 2, Want: 3, Got: 2},
+	))
+}
@@ -0,0 +1,26 @@
+package mypkg
+
+// SumInto writes the sum of multiple vectors of the same length to dst,
+// without allocating; an error is returned if one of the vectors has a
+// different length then dst. With no vectors, dst is zeroed.
+//
+// It's safe to pass one of the input vectors as dst.
+func SumInto[T Number](dst Vector[T], vectors ...Vector[T]) error {
+	if len(vectors) == 0 {
+		clear(dst)
+		return nil
+	}
+	for i, v := range vectors {
+		if len(v) != len(dst) {
+			return DimensionError{Index: i + 1, Want: len(dst), Got: len(v)}
+		}
+	}
+	for i := range dst {
+		var sum T
+		for _, v := range vectors {
+			sum += v[i]
+		}
+		dst[i] = sum
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSumInto(t *testing.T) {
+	t.Run("With a separate dst", func(t *testing.T) {
+		a := mypkg.Vector[float64]{1, 0, 3}
+		b := mypkg.Vector[float64]{0, 1, -2}
+		dst := make(mypkg.Vector[float64], 3)
+		err := mypkg.SumInto(dst, a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect dst to hold the sum", subtest.Value(dst).DeepEqual(mypkg.Vector[float64]{1, 1, 1}))
+		t.Run("Expect inputs are unchanged", subtest.Value([]mypkg.Vector[float64]{a, b}).DeepEqual(
+			[]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2}},
+		))
+	})
+	t.Run("With an input vector as dst", func(t *testing.T) {
+		a := mypkg.Vector[float64]{1, 0, 3}
+		b := mypkg.Vector[float64]{0, 1, -2}
+		err := mypkg.SumInto(b, a, b)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect dst to hold the sum", subtest.Value(b).DeepEqual(mypkg.Vector[float64]{1, 1, 1}))
+	})
+	t.Run("With dst of unequal length", func(t *testing.T) {
+		dst := make(mypkg.Vector[float64], 2)
+		err := mypkg.SumInto(dst, mypkg.Vector[float64]{1, 0, 3})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		dst := make(mypkg.Vector[float64], 3)
+		err := mypkg.SumInto(dst, mypkg.Vector[float64]{1, 0, 3}, mypkg.Vector[float64]{1})
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+	t.Run("With no vectors", func(t *testing.T) {
+		dst := mypkg.Vector[float64]{1, 2}
+		err := mypkg.SumInto(dst)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect dst to be zeroed", subtest.Value(dst).DeepEqual(mypkg.Vector[float64]{0, 0}))
+	})
+	t.Run("When called repeatedly", func(t *testing.T) {
+		vectors := benchmarkVectors(10, 100)
+		dst := make(mypkg.Vector[float64], 100)
+		allocs := testing.AllocsPerRun(100, func() {
+			_ = mypkg.SumInto(dst, vectors...)
+		})
+		t.Run("Expect no allocations", subtest.Value(allocs).NumericEqual(0))
+	})
+}
+
+func BenchmarkSumInto(b *testing.B) {
+	vectors := benchmarkVectors(10, 1000)
+	dst := make(mypkg.Vector[float64], 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := mypkg.SumInto(dst, vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSum_allocating(b *testing.B) {
+	vectors := benchmarkVectors(10, 1000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := mypkg.Sum(vectors...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
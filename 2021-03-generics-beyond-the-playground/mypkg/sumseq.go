@@ -0,0 +1,52 @@
+package mypkg
+
+import "iter"
+
+// SumSeq returns the sum of the vectors yielded by seq, without collecting them
+// into a slice first; a DimensionError is returned if a vector has a different
+// length then the first one. The error's Index is the position of the vector
+// in the sequence.
+func SumSeq[T Number](seq iter.Seq[Vector[T]]) (Vector[T], error) {
+	var sum Vector[T]
+	i := 0
+	for v := range seq {
+		if err := addAt(&sum, i, v); err != nil {
+			return nil, err
+		}
+		i++
+	}
+	return sum, nil
+}
+
+// SumSeq2 is like SumSeq, but for sequences that pair each vector with an
+// error, such as the one returned by ReadNDJSON. The first non-nil error from
+// seq is returned as is, and stops the iteration.
+func SumSeq2[T Number](seq iter.Seq2[Vector[T], error]) (Vector[T], error) {
+	var sum Vector[T]
+	i := 0
+	for v, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		if err := addAt(&sum, i, v); err != nil {
+			return nil, err
+		}
+		i++
+	}
+	return sum, nil
+}
+
+// addAt adds v, the i-th vector of a sequence, to sum. For i == 0, sum is
+// allocated with the length of v.
+func addAt[T Number](sum *Vector[T], i int, v Vector[T]) error {
+	if i == 0 {
+		*sum = make(Vector[T], len(v))
+	}
+	if len(v) != len(*sum) {
+		return DimensionError{Index: i, Want: len(*sum), Got: len(v)}
+	}
+	for j, x := range v.All() {
+		(*sum)[j] += x
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package mypkg_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestSumSeq(t *testing.T) {
+	t.Run("With vectors of equal length", func(t *testing.T) {
+		seq := slices.Values([]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2}})
+		result, err := mypkg.SumSeq(seq)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{1, 1, 1}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		seq := slices.Values([]mypkg.Vector[float64]{{1, 0, 3}, {0, 1, -2}, {1}})
+		_, err := mypkg.SumSeq(seq)
+		t.Run("Expect dimension error for the third vector", subtest.Value(err).DeepEqual(
+			mypkg.DimensionError{Index: 2, Want: 3, Got: 1},
+		))
+	})
+	t.Run("With an empty sequence", func(t *testing.T) {
+		result, err := mypkg.SumSeq(slices.Values([]mypkg.Vector[float64]{}))
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect nil", subtest.Value(result).DeepEqual(mypkg.Vector[float64](nil)))
+	})
+}
+
+func TestSumSeq2(t *testing.T) {
+	t.Run("With valid NDJSON input", func(t *testing.T) {
+		r := strings.NewReader("[1, 0, 3]\n[0, 1, -2]\n")
+		result, err := mypkg.SumSeq2(mypkg.ReadNDJSON[float64](r))
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect correct sum", subtest.Value(result).DeepEqual(mypkg.Vector[float64]{1, 1, 1}))
+	})
+	t.Run("With invalid NDJSON input", func(t *testing.T) {
+		r := strings.NewReader("[1, 0, 3]\n[0, x]\n")
+		_, err := mypkg.SumSeq2(mypkg.ReadNDJSON[float64](r))
+		t.Run("Expect decode error", subtest.Value(err).MatchPattern(`^line 2: `))
+	})
+}
@@ -0,0 +1,109 @@
+package mypkg
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FormatVector returns v formatted as a space-separated list in square
+// brackets, e.g. "[1 2.5 -3]", the same as fmt's default slice formatting. The
+// result can be parsed with ParseVector.
+//
+// Vector deliberately doesn't implement fmt.Stringer, as that would change how
+// test libraries print failing vectors.
+func FormatVector[T Number](v Vector[T]) string {
+	return fmt.Sprint([]T(v))
+}
+
+// MarshalText implements encoding.TextMarshaler using FormatVector. Together
+// with UnmarshalText, this lets vectors be used with flag.TextVar.
+func (v Vector[T]) MarshalText() ([]byte, error) {
+	return []byte(FormatVector(v)), nil
+}
+
+// MarshalYAML implements yaml.v3's Marshaler interface. Without it, yaml.v3
+// would use MarshalText and write vectors as strings instead of sequences.
+func (v Vector[T]) MarshalYAML() (interface{}, error) {
+	return []T(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseVector. This
+// lets vectors be read from flags via flag.TextVar, and from YAML as either a
+// sequence or a string like "(1, 2, 3)".
+func (v *Vector[T]) UnmarshalText(b []byte) error {
+	target, err := ParseVector[T](string(b))
+	if err != nil {
+		return err
+	}
+	*v = target
+	return nil
+}
+
+// ParseVector parses a list of numbers separated by either commas or
+// whitespace, optionally wrapped in square brackets or parentheses. E.g.
+// "(1, 2, 3)", "[1 2 3]" and "1,2,3" all give the same vector. When commas are
+// used, empty fields such as in "1,,2" are rejected. Complex elements use the
+// format accepted by strconv.ParseComplex; when there is more than one, the
+// list must be wrapped in square brackets.
+func ParseVector[T Number](s string) (Vector[T], error) {
+	s = strings.TrimSpace(s)
+	if n := len(s); n >= 2 {
+		switch {
+		case s[0] == '[' && s[n-1] == ']':
+			s = s[1 : n-1]
+		case s[0] == '(' && s[n-1] == ')' && !strings.ContainsAny(s[1:n-1], "()"):
+			s = s[1 : n-1]
+		}
+	}
+	var fields []string
+	if strings.Contains(s, ",") {
+		fields = strings.Split(s, ",")
+	} else {
+		fields = strings.Fields(s)
+	}
+	target := make(Vector[T], len(fields))
+	for i, f := range fields {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			return nil, fmt.Errorf("vector element %d: empty field", i)
+		}
+		if err := parseElement(f, &target[i]); err != nil {
+			return nil, fmt.Errorf("vector element %d: %w", i, err)
+		}
+	}
+	return target, nil
+}
+
+func parseElement[T Number](s string, dst *T) error {
+	rv := reflect.ValueOf(dst).Elem()
+	bits := rv.Type().Bits()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, err := strconv.ParseInt(s, 10, bits)
+		if err != nil {
+			return err
+		}
+		rv.SetInt(x)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, err := strconv.ParseUint(s, 10, bits)
+		if err != nil {
+			return err
+		}
+		rv.SetUint(x)
+	case reflect.Float32, reflect.Float64:
+		x, err := strconv.ParseFloat(s, bits)
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(x)
+	default:
+		x, err := strconv.ParseComplex(s, bits)
+		if err != nil {
+			return err
+		}
+		rv.SetComplex(x)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package mypkg_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ encoding.TextMarshaler   = mypkg.Vector[float64](nil)
+	_ encoding.TextUnmarshaler = (*mypkg.Vector[float64])(nil)
+)
+
+func TestParseVector(t *testing.T) {
+	for _, s := range []string{"(1, 2.5, -3)", "[1 2.5 -3]", "  1,2.5,\t-3 ", "[1, 2.5, -3]"} {
+		t.Run("With input "+s, func(t *testing.T) {
+			v, err := mypkg.ParseVector[float64](s)
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect parsed vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 2.5, -3}))
+		})
+	}
+	t.Run("With empty brackets", func(t *testing.T) {
+		v, err := mypkg.ParseVector[float64]("[]")
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect empty vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{}))
+	})
+	for _, s := range []string{"1,,2", "(1, 2,)", ", 1"} {
+		t.Run("With empty field in "+s, func(t *testing.T) {
+			_, err := mypkg.ParseVector[float64](s)
+			t.Run("Expect error", subtest.Value(err).MatchPattern(`^vector element \d+: empty field$`))
+		})
+	}
+	t.Run("With a non-numeric element", func(t *testing.T) {
+		_, err := mypkg.ParseVector[float64]("(1, x, 3)")
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With a fraction for an int vector", func(t *testing.T) {
+		_, err := mypkg.ParseVector[int]("1 2.5")
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With an out of range uint8 element", func(t *testing.T) {
+		_, err := mypkg.ParseVector[uint8]("[255, 256]")
+		t.Run("Expect error with element index", subtest.Value(err).MatchPattern(`^vector element 1: `))
+	})
+	t.Run("With complex elements", func(t *testing.T) {
+		v, err := mypkg.ParseVector[complex128]("[(1+2i) (3+0i)]")
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect parsed vector", subtest.Value(v).DeepEqual(mypkg.Vector[complex128]{1 + 2i, 3}))
+	})
+}
+
+func TestFormatVector(t *testing.T) {
+	t.Run("With float64 vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 2.5, -3, 0.1}
+		s := mypkg.FormatVector(v)
+		t.Run("Expect fmt-style output", subtest.Value(s).DeepEqual("[1 2.5 -3 0.1]"))
+		parsed, err := mypkg.ParseVector[float64](s)
+		t.Run("Expect round-trip without error", subtest.Value(err).NoError())
+		t.Run("Expect round-trip to equal vector", subtest.Value(parsed).DeepEqual(v))
+	})
+	t.Run("With complex64 vector", func(t *testing.T) {
+		v := mypkg.Vector[complex64]{1 + 2i, -0.5i}
+		parsed, err := mypkg.ParseVector[complex64](mypkg.FormatVector(v))
+		t.Run("Expect round-trip without error", subtest.Value(err).NoError())
+		t.Run("Expect round-trip to equal vector", subtest.Value(parsed).DeepEqual(v))
+	})
+}
+
+func TestVectorUnmarshalText(t *testing.T) {
+	var v mypkg.Vector[int]
+	err := v.UnmarshalText([]byte("(1, 2, 3)"))
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect parsed vector", subtest.Value(v).DeepEqual(mypkg.Vector[int]{1, 2, 3}))
+}
+
+func TestVectorMarshalText(t *testing.T) {
+	b, err := mypkg.Vector[float64]{1, 2.5, -3}.MarshalText()
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect FormatVector output", subtest.Value(string(b)).DeepEqual("[1 2.5 -3]"))
+	t.Run("Expect JSON to still encode an array", func(t *testing.T) {
+		b, err := json.Marshal(mypkg.Vector[float64]{1, 2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect array", subtest.Value(string(b)).DeepEqual("[1,2]"))
+	})
+}
+
+func TestVectorTextVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var v mypkg.Vector[int]
+	fs.TextVar(&v, "v", mypkg.Vector[int]{1, 2}, "a vector")
+	t.Run("Expect default in usage", subtest.Value(fs.Lookup("v").DefValue).DeepEqual("[1 2]"))
+	t.Run("Expect default value", subtest.Value(v).DeepEqual(mypkg.Vector[int]{1, 2}))
+	err := fs.Parse([]string{"-v", "(3, 4, 5)"})
+	t.Run("Expect no parse error", subtest.Value(err).NoError())
+	t.Run("Expect parsed value", subtest.Value(v).DeepEqual(mypkg.Vector[int]{3, 4, 5}))
+}
+
+func TestVectorYAML(t *testing.T) {
+	t.Run("When marshaling", func(t *testing.T) {
+		b, err := yaml.Marshal(mypkg.Vector[float64]{1, 2})
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect a YAML sequence", subtest.Value(string(b)).DeepEqual("- 1\n- 2\n"))
+	})
+	t.Run("When unmarshaling a sequence", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := yaml.Unmarshal([]byte("[1, 2]"), &v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect parsed vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 2}))
+	})
+	t.Run("When unmarshaling a string", func(t *testing.T) {
+		var v mypkg.Vector[float64]
+		err := yaml.Unmarshal([]byte("'(1, 2)'"), &v)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect parsed vector", subtest.Value(v).DeepEqual(mypkg.Vector[float64]{1, 2}))
+	})
+}
@@ -0,0 +1,44 @@
+package mypkg
+
+import "iter"
+
+// Number is a constraint that permits any integer, floating-point or complex
+// type.
+type Number interface {
+	Real | Complex
+}
+
+// Real is a constraint that permits any integer or floating-point type.
+type Real interface {
+	Integer | Float
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is a constraint that permits any floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Complex is a constraint that permits any complex type.
+type Complex interface {
+	~complex64 | ~complex128
+}
+
+// Vector is a vector of numeric elements of type T.
+type Vector[T Number] []T
+
+// All returns an iterator over the index and value of each element in v.
+func (v Vector[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, x := range v {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package mypkg_test
+
+import (
+	"testing"
+
+	"github.com/searis/subtest"
+	"github.com/smyrman/blog/2021-03-generics-beyond-the-playground/mypkg"
+)
+
+func TestVectorAll(t *testing.T) {
+	t.Run("With non-empty vector", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, 3}
+		var indices []int
+		var values []float64
+		for i, x := range v.All() {
+			indices = append(indices, i)
+			values = append(values, x)
+		}
+		t.Run("Expect all indices", subtest.Value(indices).DeepEqual([]int{0, 1, 2}))
+		t.Run("Expect all values", subtest.Value(values).DeepEqual([]float64{1, 0, 3}))
+	})
+	t.Run("With early break", func(t *testing.T) {
+		v := mypkg.Vector[float64]{1, 0, 3}
+		var values []float64
+		for _, x := range v.All() {
+			values = append(values, x)
+			break
+		}
+		t.Run("Expect iteration to stop", subtest.Value(values).DeepEqual([]float64{1}))
+	})
+}
+
+func TestSum_int64(t *testing.T) {
+	t.Run("With a single vector", func(t *testing.T) {
+		a := mypkg.Vector[int64]{1, 0, 3}
+		result, err := mypkg.Sum(a)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expect the same elements", subtest.Value(result).DeepEqual(mypkg.Vector[int64]{1, 0, 3}))
+	})
+	t.Run("With vectors of unequal length", func(t *testing.T) {
+		a := mypkg.Vector[int64]{1, 0, 3}
+		b := mypkg.Vector[int64]{0, 1}
+		_, err := mypkg.Sum(a, b)
+		t.Run("Expect an error", subtest.Value(err).Error())
+	})
+}